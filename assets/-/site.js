@@ -408,6 +408,10 @@ $(function() {
         $(e.target).select();
     });
 
+    $('#x-show-unexported').on('change', function(e) {
+        $('body').toggleClass('hide-unexported', !e.target.checked);
+    });
+
     $('body').scrollspy({
         target: '.gddo-sidebar',
         offset: 10
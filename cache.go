@@ -0,0 +1,363 @@
+package main
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/ghetzel/go-stockutil/log"
+)
+
+// Caches the results of analyzing packages on disk, so that packages whose source files haven't
+// changed needn't be parsed again.  Entries are specific to the directory they describe, the
+// generator version, and the options that affect analysis; they are only used if the content of
+// every Go source file in the directory is unchanged.
+//
+// Only what a package declares is cached.  Where a package is (its import paths and repository URL)
+// is always determined anew, and type-checked scans are never cached, since their results also depend
+// on other packages.
+type scanCache struct {
+	dir         string
+	fingerprint string
+}
+
+// A cached package, along with what it was derived from.
+type cacheEntry struct {
+	Version     string
+	Fingerprint string
+	Files       map[string]string
+	Links       *packageLinks `json:",omitempty"`
+	Package     *Package
+}
+
+// The state of a package that isn't part of its JSON representation: which file each type and
+// function is declared in (by name, and "Type.Method" for methods), and the platforms it was
+// evaluated for.
+type packageLinks struct {
+	TypeFiles     map[string]string   `json:",omitempty"`
+	FuncFiles     map[string]string   `json:",omitempty"`
+	Platforms     []string            `json:",omitempty"`
+	FilePlatforms map[string][]string `json:",omitempty"`
+	TestPackage   *packageLinks       `json:",omitempty"`
+}
+
+// Returns the cache to use for the given options, or nil if caching is disabled.
+func newScanCache(options *ScanOptions) *scanCache {
+	if options.NoCache || options.TypeCheck {
+		return nil
+	}
+
+	var cache = &scanCache{
+		dir: options.CacheDir,
+	}
+
+	if cache.dir == `` {
+		if dir, err := os.UserCacheDir(); err == nil {
+			cache.dir = filepath.Join(dir, `owndoc`)
+		} else {
+			log.Warningf("scan cache disabled: %v", err)
+			return nil
+		}
+	}
+
+	if err := os.MkdirAll(cache.dir, 0700); err != nil {
+		log.Warningf("scan cache disabled: %v", err)
+		return nil
+	}
+
+	// a package with broken files is documented in tolerant mode, but is an error otherwise
+	var fingerprint = []string{
+		Version,
+		executableID(),
+		fmt.Sprintf("tolerant=%v", options.Tolerant),
+		fmt.Sprintf("unexported=%v", options.Unexported),
+	}
+
+	// the resolved platforms are hashed, so that the default (the host's) isn't shared between hosts
+	if platforms, err := scanPlatforms(options); err == nil {
+		for _, platform := range platforms {
+			fingerprint = append(fingerprint, platform.String())
+		}
+	} else {
+		log.Warningf("scan cache disabled: %v", err)
+		return nil
+	}
+
+	cache.fingerprint = hashStrings(fingerprint...)
+
+	return cache
+}
+
+// Analyzes the package in the given directory, reusing the cached result if its source hasn't changed.
+func (self *scanCache) analyzePackage(pkgdir string, parentName string, options *ScanOptions) (*Package, error) {
+	if self == nil {
+		return analyzePackage(pkgdir, parentName, options)
+	}
+
+	var hashes, err = hashSources(pkgdir)
+
+	if err != nil {
+		log.Warningf("cannot cache %s: %v", pkgdir, err)
+		return analyzePackage(pkgdir, parentName, options)
+	}
+
+	var entryPath = self.entryPath(pkgdir)
+
+	if pkg := self.load(entryPath, hashes); pkg != nil {
+		log.Infof("load package from cache: %s", pkgdir)
+
+		if err := pkg.setLocation(pkgdir, parentName, options); err == nil {
+			return pkg, nil
+		} else {
+			return nil, err
+		}
+	}
+
+	if pkg, err := analyzePackage(pkgdir, parentName, options); err == nil {
+		if pkg != nil {
+			self.store(entryPath, hashes, pkg)
+		}
+
+		return pkg, nil
+	} else {
+		return nil, err
+	}
+}
+
+func (self *scanCache) entryPath(pkgdir string) string {
+	if abs, err := filepath.Abs(pkgdir); err == nil {
+		pkgdir = abs
+	}
+
+	return filepath.Join(self.dir, hashStrings(pkgdir, self.fingerprint)+`.json`)
+}
+
+// Returns the cached package at the given path, provided it was derived from exactly the given files.
+func (self *scanCache) load(entryPath string, hashes map[string]string) *Package {
+	var entry cacheEntry
+
+	if data, err := ioutil.ReadFile(entryPath); err == nil {
+		if err := json.Unmarshal(data, &entry); err != nil {
+			log.Warningf("ignoring corrupt cache entry %s: %v", entryPath, err)
+			return nil
+		}
+	} else {
+		return nil
+	}
+
+	if entry.Version != Version || entry.Fingerprint != self.fingerprint || entry.Package == nil {
+		return nil
+	} else if len(entry.Files) != len(hashes) {
+		return nil
+	}
+
+	for name, hash := range hashes {
+		if entry.Files[name] != hash {
+			return nil
+		}
+	}
+
+	entry.Package.relink(entry.Links)
+
+	return entry.Package
+}
+
+func (self *scanCache) store(entryPath string, hashes map[string]string, pkg *Package) {
+	var entry = cacheEntry{
+		Version:     Version,
+		Fingerprint: self.fingerprint,
+		Files:       hashes,
+		Links:       pkg.links(),
+		Package:     pkg,
+	}
+
+	if data, err := json.Marshal(&entry); err == nil {
+		// write to a temporary file first so that concurrent readers never see a partial entry
+		if tmp, err := ioutil.TempFile(self.dir, `.entry-*`); err == nil {
+			_, err = tmp.Write(data)
+
+			if cerr := tmp.Close(); err == nil {
+				err = cerr
+			}
+
+			if err == nil {
+				err = os.Rename(tmp.Name(), entryPath)
+			}
+
+			if err != nil {
+				os.Remove(tmp.Name())
+				log.Warningf("cannot write cache entry for %s: %v", pkg.ImportPath, err)
+			}
+		} else {
+			log.Warningf("cannot write cache entry for %s: %v", pkg.ImportPath, err)
+		}
+	} else {
+		log.Warningf("cannot encode cache entry for %s: %v", pkg.ImportPath, err)
+	}
+}
+
+// Returns the state of the package that relink restores it from.
+func (self *Package) links() *packageLinks {
+	var links = &packageLinks{
+		TypeFiles:     make(map[string]string),
+		FuncFiles:     make(map[string]string),
+		Platforms:     self.platforms,
+		FilePlatforms: self.filePlatforms,
+	}
+
+	for name, typ := range self.Types {
+		if typ.File != nil {
+			links.TypeFiles[name] = typ.File.Name
+		}
+
+		for _, method := range typ.InterfaceMethods {
+			if method.File != nil {
+				links.FuncFiles[symbolKey(name, method.Name)] = method.File.Name
+			}
+		}
+	}
+
+	for _, method := range self.funcs {
+		if method.File != nil {
+			links.FuncFiles[symbolKey(method.receiverTypeName, method.Name)] = method.File.Name
+		}
+	}
+
+	if self.TestPackage != nil {
+		links.TestPackage = self.TestPackage.links()
+	}
+
+	return links
+}
+
+// Restores what isn't serialized: the references between a package's files, types and methods, and
+// the state its analysis leaves behind.  Only the syntax trees are lost, and nothing needs them once
+// a package has been analyzed.
+func (self *Package) relink(links *packageLinks) {
+	var files = make(map[string]*File)
+	var typeNames = make([]string, 0, len(self.Types))
+
+	if links == nil {
+		links = new(packageLinks)
+	}
+
+	self.platforms = links.Platforms
+	self.filePlatforms = links.FilePlatforms
+	self.funcs = nil
+	self.valueGroups = nil
+
+	for _, file := range self.Files {
+		file.Package = self
+		files[file.Name] = file
+	}
+
+	for _, list := range [][]*Method{self.Functions, self.Tests} {
+		for _, method := range list {
+			method.File = files[links.FuncFiles[method.Name]]
+			self.funcs = append(self.funcs, method)
+		}
+	}
+
+	self.valueGroups = append(self.valueGroups, self.ConstantGroups...)
+	self.valueGroups = append(self.valueGroups, self.VariableGroups...)
+
+	for name := range self.Types {
+		typeNames = append(typeNames, name)
+	}
+
+	// (in a fixed order, so that declarations are reported in the same order every time)
+	sort.Strings(typeNames)
+
+	for _, name := range typeNames {
+		var typ = self.Types[name]
+
+		typ.File = files[links.TypeFiles[name]]
+
+		for _, method := range typ.Methods {
+			if !method.IsPackageLevel {
+				method.receiverTypeName = name
+			}
+
+			method.Parent = typ
+			method.File = files[links.FuncFiles[symbolKey(method.receiverTypeName, method.Name)]]
+			self.funcs = append(self.funcs, method)
+		}
+
+		for _, method := range typ.InterfaceMethods {
+			method.Parent = typ
+			method.File = files[links.FuncFiles[symbolKey(name, method.Name)]]
+		}
+
+		for _, field := range typ.Fields {
+			field.Parent = typ
+		}
+
+		self.valueGroups = append(self.valueGroups, typ.Constants...)
+		self.valueGroups = append(self.valueGroups, typ.Variables...)
+	}
+
+	if self.TestPackage != nil {
+		self.TestPackage.relink(links.TestPackage)
+	}
+
+	// examples are shared between the package and the declarations they document
+	self.associateExamples()
+}
+
+// Returns the key a function is known by in the cache: its name, qualified with the name of its
+// receiver's type if it's a method.
+func symbolKey(recv string, name string) string {
+	if recv == `` {
+		return name
+	}
+
+	return recv + `.` + name
+}
+
+// Hashes the content of every Go source file in the given directory, by file name.
+func hashSources(pkgdir string) (map[string]string, error) {
+	var hashes = make(map[string]string)
+
+	if entries, err := ioutil.ReadDir(pkgdir); err == nil {
+		for _, entry := range entries {
+			if entry.IsDir() || !strings.HasSuffix(entry.Name(), `.go`) {
+				continue
+			}
+
+			if data, err := ioutil.ReadFile(filepath.Join(pkgdir, entry.Name())); err == nil {
+				var sum = sha256.Sum256(data)
+				hashes[entry.Name()] = hex.EncodeToString(sum[:])
+			} else {
+				return nil, err
+			}
+		}
+
+		return hashes, nil
+	} else {
+		return nil, err
+	}
+}
+
+// Identifies the running executable, so that entries written by other builds of the same version
+// aren't used.
+func executableID() string {
+	if exe, err := os.Executable(); err == nil {
+		if stat, err := os.Stat(exe); err == nil {
+			return fmt.Sprintf("%s:%d:%d", exe, stat.Size(), stat.ModTime().UnixNano())
+		}
+	}
+
+	return ``
+}
+
+func hashStrings(values ...string) string {
+	var sum = sha256.Sum256([]byte(strings.Join(values, "\x00")))
+
+	return hex.EncodeToString(sum[:])
+}
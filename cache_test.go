@@ -0,0 +1,130 @@
+package main
+
+import (
+	"encoding/json"
+	"os"
+	"testing"
+)
+
+var cacheTestFiles = map[string]string{
+	`shape.go`: `// Package shape draws shapes.
+package shape
+
+// A kind of shape.
+type Kind int
+
+// The kinds of shape.
+const (
+	Circle Kind = iota
+	Square
+)
+
+// The number of sides of a triangle.
+const Sides = 3
+
+// A shape.
+type Shape struct {
+	Kind Kind ` + "`json:\"kind\"`" + `
+	Size float64
+}
+`,
+	`draw.go`: `package shape
+
+// Returns a new shape of the given kind.
+func New(kind Kind) *Shape {
+	return &Shape{Kind: kind}
+}
+
+// Draws the shape.
+func (s *Shape) Draw() string {
+	return s.Kind.String()
+}
+
+// Returns the name of the kind.
+func (k Kind) String() string {
+	return [...]string{` + "`circle`, `square`" + `}[k]
+}
+
+// Scales every shape.
+func Scale(shapes []*Shape, factor float64) {}
+`,
+	`draw_linux.go`: `package shape
+
+// Draws the shape with a native toolkit.
+func (s *Shape) Native() {}
+`,
+	`square.go`: `package shape
+
+// A square, which is a shape.
+type Squared struct {
+	*Shape
+	Label string
+}
+`,
+	`shape_test.go`: `package shape_test
+
+import (
+	"fmt"
+
+	"example.com/test"
+)
+
+func ExampleNew() {
+	fmt.Println(shape.New(shape.Square).Draw())
+	// Output: square
+}
+
+func ExampleShape_Draw() {
+	fmt.Println(shape.New(shape.Circle).Draw())
+	// Output: circle
+}
+`,
+	`sub/sub.go`: `// Package sub is beneath shape.
+package sub
+
+import shape "example.com/test"
+
+// A shape with a name.
+type Named struct {
+	shape.Shape
+	Name string
+}
+`,
+}
+
+// A package loaded from the cache should be documented exactly as one that was just scanned.
+func TestCachedScanMatchesFreshScan(t *testing.T) {
+	var dir = writeTestModule(t, cacheTestFiles)
+	var cacheDir = t.TempDir()
+
+	var scan = func(options *ScanOptions) string {
+		options.StartDir = dir
+		options.Offline = true
+
+		if mod, err := ScanDir(options); err == nil {
+			if data, err := json.MarshalIndent(mod, ``, `  `); err == nil {
+				return string(data)
+			} else {
+				t.Fatal(err)
+			}
+		} else {
+			t.Fatalf("cannot scan: %v", err)
+		}
+
+		return ``
+	}
+
+	var fresh = scan(&ScanOptions{NoCache: true})
+
+	if stored := scan(&ScanOptions{CacheDir: cacheDir}); stored != fresh {
+		t.Errorf("scan that populated the cache differs from a fresh scan")
+	}
+
+	if entries, err := os.ReadDir(cacheDir); err != nil || len(entries) != 2 {
+		t.Fatalf("expected an entry per package in the cache, got %d (%v)", len(entries), err)
+	}
+
+	if cached := scan(&ScanOptions{CacheDir: cacheDir}); cached != fresh {
+		t.Errorf("cached scan differs from a fresh scan:\n%s\n\nwant:\n%s", cached, fresh)
+	}
+}
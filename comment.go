@@ -0,0 +1,399 @@
+package main
+
+import (
+	"fmt"
+	"go/doc/comment"
+	"html"
+	"path"
+	"path/filepath"
+	"strings"
+	"text/template"
+
+	"github.com/ghetzel/go-stockutil/log"
+)
+
+// Renders doc comments (in Go doc comment syntax) to HTML.  Doc links, e.g.: [Type.Method] or
+// [pkg.Func], are resolved against the packages in the module, and point to the corresponding
+// anchors in the generated site; so do the types referred to in declarations.  Names are resolved in
+// the scope of pkg, and links are relative to the page of page (usually the same package).
+type commentRenderer struct {
+	module   *Module
+	pkg      *Package
+	page     *Package
+	parser   *comment.Parser
+	external *template.Template
+	packages map[string]*Package
+	anchors  map[*Package]map[string]string
+}
+
+// Renders every doc comment in the module, and links the declarations in it.  This happens once
+// every package has been loaded, so that links between packages can be resolved.
+func (self *Module) renderComments(options *ScanOptions) error {
+	var renderer = &commentRenderer{
+		module:   self,
+		packages: self.packages,
+		anchors:  make(map[*Package]map[string]string),
+	}
+
+	if tmpl, err := template.New(`external-url`).Parse(options.ExternalURL); err == nil {
+		renderer.external = tmpl
+	} else {
+		return fmt.Errorf("invalid external URL template: %v", err)
+	}
+
+	renderer.parser = &comment.Parser{
+		LookupPackage: renderer.lookupPackage,
+		LookupSym:     renderer.lookupSym,
+	}
+
+	return self.Walk(func(pkg *Package) error {
+		renderer.pkg = pkg
+		renderer.page = pkg
+		renderer.renderPackage(pkg)
+		renderer.linkPackage(pkg)
+		renderer.renderPromotedMethods(pkg)
+		return nil
+	})
+}
+
+// Renders the methods promoted to the types of the given package.  These are declared elsewhere
+// (maybe in another package), but are documented on this package's page.
+func (self *commentRenderer) renderPromotedMethods(pkg *Package) {
+	for _, typ := range pkg.Types {
+		for _, method := range typ.PromotedMethods {
+			if method.File != nil && method.File.Package != nil {
+				self.pkg = method.File.Package
+			} else {
+				self.pkg = pkg
+			}
+
+			method.CommentHTML = self.render(method.Comment)
+			self.linkMethod(method)
+		}
+	}
+
+	self.pkg = pkg
+}
+
+func (self *commentRenderer) renderPackage(pkg *Package) {
+	pkg.SynopsisHTML = self.render(pkg.Synopsis)
+
+	for _, list := range [][]*Method{pkg.Functions, pkg.Examples, pkg.Tests} {
+		for _, method := range list {
+			method.CommentHTML = self.render(method.Comment)
+		}
+	}
+
+	for _, groups := range [][]*ValueGroup{pkg.ConstantGroups, pkg.VariableGroups} {
+		self.renderValueGroups(groups)
+	}
+
+	for _, values := range [][]Value{pkg.Constants, pkg.Variables} {
+		for i := range values {
+			values[i].CommentHTML = self.render(values[i].Comment)
+		}
+	}
+
+	for _, typ := range pkg.Types {
+		typ.CommentHTML = self.render(typ.Comment)
+
+		for _, list := range [][]*Method{typ.Methods, typ.InterfaceMethods} {
+			for _, method := range list {
+				method.CommentHTML = self.render(method.Comment)
+			}
+		}
+
+		for _, field := range typ.Fields {
+			field.CommentHTML = self.render(field.Comment)
+		}
+
+		self.renderValueGroups(typ.Constants)
+		self.renderValueGroups(typ.Variables)
+	}
+}
+
+func (self *commentRenderer) renderValueGroups(groups []*ValueGroup) {
+	for _, group := range groups {
+		group.CommentHTML = self.render(group.Comment)
+
+		for i := range group.Values {
+			group.Values[i].CommentHTML = self.render(group.Values[i].Comment)
+		}
+	}
+}
+
+// Renders the given comment text in the context of the current package.
+func (self *commentRenderer) render(text string) string {
+	if text == `` {
+		return ``
+	}
+
+	var blocks []string
+
+	for _, block := range self.parser.Parse(text).Content {
+		blocks = append(blocks, self.blockHTML(block, false))
+	}
+
+	// every block is closed and followed by a blank line, so that templates can pass the result
+	// through markdown unchanged (as raw HTML blocks)
+	return strings.Join(blocks, "\n\n")
+}
+
+func (self *commentRenderer) blockHTML(block comment.Block, tight bool) string {
+	switch block := block.(type) {
+	case *comment.Paragraph:
+		if tight {
+			return self.textHTML(block.Text)
+		} else {
+			return `<p>` + self.textHTML(block.Text) + `</p>`
+		}
+	case *comment.Heading:
+		// package documentation sections are h3
+		return fmt.Sprintf("<h4 id=\"%s\">%s</h4>", html.EscapeString(block.DefaultID()), self.textHTML(block.Text))
+	case *comment.Code:
+		return `<pre>` + html.EscapeString(block.Text) + `</pre>`
+	case *comment.List:
+		var tag = `ul`
+		var items []string
+
+		if len(block.Items) > 0 && block.Items[0].Number != `` {
+			tag = `ol`
+		}
+
+		for _, item := range block.Items {
+			var content []string
+
+			for _, b := range item.Content {
+				content = append(content, self.blockHTML(b, !block.BlankBetween()))
+			}
+
+			items = append(items, `<li>`+strings.Join(content, "\n")+`</li>`)
+		}
+
+		return `<` + tag + `>` + "\n" + strings.Join(items, "\n") + "\n" + `</` + tag + `>`
+	default:
+		return ``
+	}
+}
+
+func (self *commentRenderer) textHTML(text []comment.Text) string {
+	var out strings.Builder
+
+	for _, t := range text {
+		switch t := t.(type) {
+		case comment.Plain:
+			out.WriteString(html.EscapeString(string(t)))
+		case comment.Italic:
+			out.WriteString(`<i>` + html.EscapeString(string(t)) + `</i>`)
+		case *comment.Link:
+			out.WriteString(`<a href="` + html.EscapeString(t.URL) + `">` + self.textHTML(t.Text) + `</a>`)
+		case *comment.DocLink:
+			if url := self.docLinkURL(t); url != `` {
+				out.WriteString(`<a href="` + html.EscapeString(url) + `">` + self.textHTML(t.Text) + `</a>`)
+			} else {
+				out.WriteString(self.textHTML(t.Text))
+			}
+		}
+	}
+
+	return out.String()
+}
+
+// Resolves a package name used in a doc link (e.g.: "json" in [json.Marshal]) to its import path,
+// using the imports of the current package.
+func (self *commentRenderer) lookupPackage(name string) (string, bool) {
+	if name == self.pkg.Name {
+		return self.pkg.CanonicalImportPath, true
+	}
+
+	for _, file := range self.pkg.Files {
+		for _, imp := range file.Imports {
+			if imp.Alias == name {
+				return imp.PackageName, true
+			}
+		}
+	}
+
+	// standard library packages are recognized regardless
+	return ``, false
+}
+
+// Reports whether the current package documents the given symbol.
+func (self *commentRenderer) lookupSym(recv string, name string) bool {
+	_, ok := self.anchorsFor(self.pkg)[symbolKey(recv, name)]
+	return ok
+}
+
+// Returns the URL a doc link points to: an anchor in the page of a package in this module, or
+// the external documentation of any other package.
+func (self *commentRenderer) docLinkURL(link *comment.DocLink) string {
+	var target = self.pkg
+
+	if link.ImportPath != `` && link.ImportPath != self.pkg.CanonicalImportPath {
+		target = self.packages[link.ImportPath]
+	}
+
+	if target == nil {
+		return self.externalURL(link.ImportPath, symbolKey(link.Recv, link.Name))
+	}
+
+	var url string
+
+	if target != self.page {
+		url = packagePageURL(self.page, target)
+	}
+
+	if anchor, ok := self.anchorsFor(target)[symbolKey(link.Recv, link.Name)]; ok && link.Name != `` {
+		url += `#` + anchor
+	}
+
+	return url
+}
+
+// Returns the anchors (as used in pkg.html) of every symbol the given package documents, keyed by
+// "Name" or "Type.Name".
+func (self *commentRenderer) anchorsFor(pkg *Package) map[string]string {
+	if anchors, ok := self.anchors[pkg]; ok {
+		return anchors
+	}
+
+	var anchors = make(map[string]string)
+
+	for _, fn := range pkg.Functions {
+		anchors[fn.Name] = fn.Name
+	}
+
+	for _, values := range [][]Value{pkg.Constants, pkg.Variables} {
+		for _, value := range values {
+			anchors[value.Name] = value.Name
+		}
+	}
+
+	for _, typ := range pkg.Types {
+		anchors[typ.Name] = typ.Name
+
+		for _, method := range typ.Methods {
+			if method.IsPackageLevel {
+				anchors[method.Name] = method.Name
+			} else {
+				anchors[symbolKey(typ.Name, method.Name)] = typ.Name + `.` + method.Name
+			}
+		}
+
+		for _, method := range typ.InterfaceMethods {
+			anchors[symbolKey(typ.Name, method.Name)] = typ.Name + `.` + method.Name
+		}
+
+		// fields don't have anchors of their own
+		for _, field := range typ.Fields {
+			anchors[symbolKey(typ.Name, field.Name)] = typ.Name
+		}
+
+		for _, groups := range [][]*ValueGroup{typ.Constants, typ.Variables} {
+			for _, group := range groups {
+				for _, value := range group.Values {
+					anchors[value.Name] = value.Name
+				}
+			}
+		}
+	}
+
+	self.anchors[pkg] = anchors
+
+	return anchors
+}
+
+// Links the types referred to in the declarations of the given package to their documentation.
+func (self *commentRenderer) linkPackage(pkg *Package) {
+	for _, fn := range pkg.Functions {
+		self.linkMethod(fn)
+	}
+
+	for _, typ := range pkg.Types {
+		self.linkTypeParams(typ.TypeParams)
+
+		for _, list := range [][]*Method{typ.Methods, typ.InterfaceMethods} {
+			for _, method := range list {
+				self.linkMethod(method)
+			}
+		}
+
+		for _, field := range typ.Fields {
+			self.linkTokens(field.TypeTokens)
+		}
+	}
+}
+
+func (self *commentRenderer) linkMethod(method *Method) {
+	self.linkTokens(method.SignatureTokens)
+	self.linkTypeParams(method.TypeParams)
+
+	for _, args := range [][]Arg{method.Arguments, method.Returns} {
+		for _, arg := range args {
+			self.linkTokens(arg.TypeTokens)
+		}
+	}
+}
+
+func (self *commentRenderer) linkTypeParams(params []TypeParam) {
+	for _, param := range params {
+		self.linkTokens(param.ConstraintTokens)
+	}
+}
+
+func (self *commentRenderer) linkTokens(tokens []Token) {
+	for i, token := range tokens {
+		if token.Symbol != `` {
+			tokens[i].URL = self.tokenURL(token)
+		}
+	}
+}
+
+// Returns the URL documenting the declaration a token refers to, or nothing if it isn't documented.
+func (self *commentRenderer) tokenURL(token Token) string {
+	var target = self.pkg
+
+	if token.ImportPath != `` {
+		if target = self.packages[token.ImportPath]; target == nil {
+			return self.externalURL(token.ImportPath, token.Symbol)
+		}
+	}
+
+	if anchor, ok := self.anchorsFor(target)[token.Symbol]; ok {
+		if target == self.page {
+			return `#` + anchor
+		} else {
+			return packagePageURL(self.page, target) + `#` + anchor
+		}
+	}
+
+	return ``
+}
+
+// Returns the URL documenting a package outside of the module (or a symbol in it), as given by the
+// external URL template.
+func (self *commentRenderer) externalURL(importPath string, symbol string) string {
+	var url strings.Builder
+
+	if err := self.external.Execute(&url, map[string]string{
+		`ImportPath`: importPath,
+		`Symbol`:     symbol,
+	}); err == nil {
+		return url.String()
+	} else {
+		log.Warningf("cannot link to %s: %v", importPath, err)
+		return ``
+	}
+}
+
+// Returns the URL of the page documenting one package, relative to the page of another.
+func packagePageURL(from *Package, to *Package) string {
+	var fromPage = path.Join(`pkg`, filepath.ToSlash(from.ImportPath))
+	var toPage = path.Join(`pkg`, filepath.ToSlash(to.ImportPath)) + `.html`
+
+	if rel, err := filepath.Rel(filepath.FromSlash(path.Dir(fromPage)), filepath.FromSlash(toPage)); err == nil {
+		return filepath.ToSlash(rel)
+	}
+
+	return `/` + toPage
+}
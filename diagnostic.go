@@ -0,0 +1,171 @@
+package main
+
+import (
+	"fmt"
+	"go/scanner"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/ghetzel/go-stockutil/log"
+	"golang.org/x/tools/go/packages"
+)
+
+const (
+	SeverityError   = `error`
+	SeverityWarning = `warning`
+)
+
+// A problem encountered while scanning, such as a file that couldn't be read or parsed.  In tolerant
+// mode, the file or package it concerns is skipped and the rest of the module is still documented.
+type Diagnostic struct {
+	Severity string
+	Package  string `json:",omitempty"`
+	File     string `json:",omitempty"`
+	Line     int    `json:",omitempty"`
+	Column   int    `json:",omitempty"`
+	Message  string
+}
+
+func (self Diagnostic) String() string {
+	var location = self.File
+
+	if location == `` {
+		location = self.Package
+	} else if self.Line > 0 {
+		location += fmt.Sprintf(":%d:%d", self.Line, self.Column)
+	}
+
+	if location != `` {
+		return fmt.Sprintf("%s: %s: %s", self.Severity, location, self.Message)
+	} else {
+		return fmt.Sprintf("%s: %s", self.Severity, self.Message)
+	}
+}
+
+// Converts an error into diagnostics, one per error if it is a list of parse errors (which carry
+// their own positions).  Otherwise, the diagnostic is attributed to the given file (if any).
+func errorDiagnostics(severity string, fname string, err error) (diags []Diagnostic) {
+	if list, ok := err.(scanner.ErrorList); ok {
+		for _, e := range list {
+			diags = append(diags, Diagnostic{
+				Severity: severity,
+				File:     filepath.Base(e.Pos.Filename),
+				Line:     e.Pos.Line,
+				Column:   e.Pos.Column,
+				Message:  e.Msg,
+			})
+		}
+
+		return
+	}
+
+	var diag = Diagnostic{
+		Severity: severity,
+		Message:  err.Error(),
+	}
+
+	if fname != `` {
+		diag.File = filepath.Base(fname)
+		diag.Message = strings.TrimPrefix(diag.Message, diag.File+`: `)
+	}
+
+	return append(diags, diag)
+}
+
+// Converts an error reported by go/packages, whose position (if any) is of the form "file:line:col".
+func packagesErrorDiagnostic(perr packages.Error) Diagnostic {
+	var diag = Diagnostic{
+		Severity: SeverityError,
+		Message:  perr.Msg,
+	}
+
+	if perr.Pos != `` && perr.Pos != `-` {
+		var parts = strings.Split(perr.Pos, `:`)
+		var numbers []int
+
+		// the file name itself may contain colons (e.g.: Windows drive letters)
+		for len(parts) > 1 {
+			if n, err := strconv.Atoi(parts[len(parts)-1]); err == nil {
+				numbers = append([]int{n}, numbers...)
+				parts = parts[:len(parts)-1]
+			} else {
+				break
+			}
+		}
+
+		diag.File = filepath.Base(strings.Join(parts, `:`))
+
+		if len(numbers) > 0 {
+			diag.Line = numbers[0]
+		}
+
+		if len(numbers) > 1 {
+			diag.Column = numbers[1]
+		}
+	}
+
+	return diag
+}
+
+// Records diagnostics against this package, logging each as it is.  Diagnostics concerning the
+// package itself leave Package unset; it is filled in when they're collected into the module.
+func (self *Package) addDiagnostics(diags ...Diagnostic) {
+	for _, diag := range diags {
+		var logged = diag
+
+		if logged.Package == `` {
+			logged.Package = self.ImportPath
+		}
+
+		if diag.Severity == SeverityError {
+			log.Errorf("%v", logged)
+		} else {
+			log.Warningf("%v", logged)
+		}
+
+		self.Diagnostics = append(self.Diagnostics, diag)
+	}
+}
+
+// Orders diagnostics by package, file and position.
+func sortDiagnostics(diags []Diagnostic) {
+	sort.SliceStable(diags, func(i int, j int) bool {
+		var a, b = diags[i], diags[j]
+
+		if a.Package != b.Package {
+			return a.Package < b.Package
+		} else if a.File != b.File {
+			return a.File < b.File
+		} else if a.Line != b.Line {
+			return a.Line < b.Line
+		}
+
+		return a.Column < b.Column
+	})
+}
+
+// The diagnostics of a directory that yielded no package at all, as an error.
+type diagnosticsError []Diagnostic
+
+func (self diagnosticsError) Error() string {
+	var messages []string
+
+	for _, diag := range self {
+		messages = append(messages, diag.String())
+	}
+
+	return strings.Join(messages, `; `)
+}
+
+// Returns the error diagnostics recorded while reading the directory.
+func (self *sourceDir) errors() (errs diagnosticsError) {
+	for _, diag := range self.diagnostics {
+		if diag.Severity == SeverityError {
+			errs = append(errs, diag)
+		}
+	}
+
+	return
+}
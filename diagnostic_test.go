@@ -0,0 +1,77 @@
+package main
+
+import (
+	"strconv"
+	"strings"
+	"testing"
+)
+
+var diagnosticTestFiles = map[string]string{
+	`good.go`: `package good
+
+// Documented despite its broken neighbours.
+func Good() {}
+
+// Implemented in assembly.
+func Fast(n int) int
+`,
+	`broken.go`: `package good
+
+func Broken( {}
+`,
+	`sub/sub.go`: `package sub
+
+func Sub() {
+`,
+	`other/other.go`: `package other
+
+func Other() {}
+`,
+}
+
+// Without tolerant mode, the first problem aborts the scan.
+func TestIntolerantScan(t *testing.T) {
+	var options = &ScanOptions{
+		StartDir: writeTestModule(t, diagnosticTestFiles),
+		Offline:  true,
+		NoCache:  true,
+	}
+
+	if _, err := ScanDir(options); err == nil {
+		t.Errorf("scan of a module with syntax errors succeeded")
+	}
+}
+
+// In tolerant mode, only the broken files and packages are skipped, and each problem is reported
+// where it was found.
+func TestTolerantScan(t *testing.T) {
+	var mod = scanTestModule(t, diagnosticTestFiles, &ScanOptions{NoCache: true, Tolerant: true})
+	var got []string
+
+	// (the parser may report several errors on the same line)
+	for _, diag := range mod.Diagnostics {
+		var location = diag.Severity + ` ` + diag.File + `:` + strconv.Itoa(diag.Line)
+
+		if len(got) == 0 || got[len(got)-1] != location {
+			got = append(got, location)
+		}
+	}
+
+	if want := `error broken.go:3 error sub.go:3`; strings.Join(got, ` `) != want {
+		t.Errorf("diagnostics = %q, want %q", got, want)
+	}
+
+	var functions []string
+
+	for _, fn := range mod.Package.Functions {
+		functions = append(functions, fn.Name)
+	}
+
+	if want := `Fast Good`; strings.Join(functions, ` `) != want {
+		t.Errorf("functions = %q, want %q", functions, want)
+	}
+
+	if pkg := mod.PackageByImportPath(`example.com/test/other`); pkg == nil || len(pkg.Functions) != 1 {
+		t.Errorf("package alongside a broken one wasn't documented")
+	}
+}
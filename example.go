@@ -0,0 +1,347 @@
+package main
+
+import (
+	"bytes"
+	"encoding/base64"
+	"fmt"
+	"go/ast"
+	"go/doc"
+	"go/format"
+	"go/printer"
+	"go/token"
+	"os/exec"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/ghetzel/go-stockutil/log"
+)
+
+const (
+	ExampleResultPassed = `pass`
+	ExampleResultFailed = `fail`
+)
+
+// The comment that ends an example's body, which isn't shown as part of its code.
+var rxExampleOutput = regexp.MustCompile(`(?is)\n[ \t]*//[ \t]*(unordered )?output:.*$`)
+
+// The line go test -v reports the outcome of an example with.
+var rxExampleResult = regexp.MustCompile(`^--- (PASS|FAIL): (Example\w*) \(`)
+
+// Extracts the examples from the given test files, in the same way go test and go doc find them.
+func (self *Package) addExamples(fset *token.FileSet, files []*ast.File) {
+	for _, ex := range doc.Examples(files...) {
+		var example = &Method{
+			Name:           `Example` + ex.Name,
+			Comment:        strings.TrimSpace(ex.Doc),
+			ExpectedOutput: ex.Output,
+			Unordered:      ex.Unordered,
+			EmptyOutput:    ex.EmptyOutput,
+			IsPackageLevel: true,
+			Exported:       true,
+		}
+
+		example.For, example.Label = splitExampleName(ex.Name)
+
+		if _, ok := ex.Code.(*ast.File); ok {
+			example.WholeFile = true
+		}
+
+		if code, err := exampleCode(fset, ex); err == nil {
+			example.Source = base64.StdEncoding.EncodeToString([]byte(code))
+		} else {
+			self.addDiagnostics(Diagnostic{
+				Severity: SeverityWarning,
+				File:     filepath.Base(fset.Position(ex.Code.Pos()).Filename),
+				Message:  `cannot format example ` + example.Name + `: ` + err.Error(),
+			})
+		}
+
+		var start = fset.Position(ex.Code.Pos())
+		var end = fset.Position(ex.Code.End())
+
+		example.Position = &Position{
+			File:      filepath.Base(start.Filename),
+			Line:      start.Line,
+			Column:    start.Column,
+			EndLine:   end.Line,
+			EndColumn: end.Column,
+		}
+
+		self.Examples = append(self.Examples, example)
+	}
+}
+
+// Returns the test files (in-package and external) among the given packages, in a stable order.
+func testFiles(pkgs ...*ast.Package) (files []*ast.File) {
+	var names []string
+	var byName = make(map[string]*ast.File)
+
+	for _, pkg := range pkgs {
+		if pkg == nil {
+			continue
+		}
+
+		for fname, file := range pkg.Files {
+			if strings.HasSuffix(fname, `_test.go`) {
+				names = append(names, fname)
+				byName[fname] = file
+			}
+		}
+	}
+
+	sort.Strings(names)
+
+	for _, fname := range names {
+		files = append(files, byName[fname])
+	}
+
+	return
+}
+
+// Returns the source of an example as it is shown: the statements in its body (less the output
+// comment), or the whole file for examples that need the file's other declarations.
+func exampleCode(fset *token.FileSet, ex *doc.Example) (string, error) {
+	var buf bytes.Buffer
+
+	if err := format.Node(&buf, fset, &printer.CommentedNode{
+		Node:     ex.Code,
+		Comments: ex.Comments,
+	}); err != nil {
+		return ``, err
+	}
+
+	var code = buf.String()
+
+	if _, ok := ex.Code.(*ast.BlockStmt); ok {
+		code = strings.TrimSpace(code)
+		code = strings.TrimPrefix(code, `{`)
+		code = strings.TrimSuffix(code, `}`)
+		code = rxExampleOutput.ReplaceAllString(code, ``)
+
+		var lines = strings.Split(strings.Trim(code, "\n"), "\n")
+
+		for i, line := range lines {
+			lines[i] = strings.TrimPrefix(line, "\t")
+		}
+
+		code = strings.Join(lines, "\n")
+	}
+
+	return strings.TrimSpace(code) + "\n", nil
+}
+
+// Splits the name of an example function (less its "Example" prefix) into the declaration it
+// documents and its suffix, following the ExampleT_M_suffix convention: e.g.: "T_M_second" is
+// "T.M" and "second", "F" is "F", and "_second" documents the package itself.
+func splitExampleName(name string) (string, string) {
+	var suffix string
+
+	if i := strings.LastIndex(name, `_`); i >= 0 {
+		if r, _ := utf8.DecodeRuneInString(name[i+1:]); unicode.IsLower(r) {
+			name, suffix = name[:i], name[i+1:]
+		}
+	}
+
+	return strings.Replace(name, `_`, `.`, 1), suffix
+}
+
+// Attaches each example to the function, type or method it documents, and returns the examples of
+// declarations that don't exist (which are only listed with the package).
+func (self *Package) associateExamples() (unmatched []*Method) {
+	for _, fn := range self.Functions {
+		fn.Examples = nil
+	}
+
+	for _, typ := range self.Types {
+		typ.Examples = nil
+
+		for _, list := range [][]*Method{typ.Methods, typ.InterfaceMethods} {
+			for _, method := range list {
+				method.Examples = nil
+			}
+		}
+	}
+
+	for _, example := range self.Examples {
+		if example.For == `` {
+			continue
+		}
+
+		var typeName, methodName = example.For, ``
+		var matched bool
+
+		if i := strings.Index(typeName, `.`); i >= 0 {
+			typeName, methodName = typeName[:i], typeName[i+1:]
+		}
+
+		if methodName != `` {
+			if typ, ok := self.Types[typeName]; ok {
+				for _, list := range [][]*Method{typ.Methods, typ.InterfaceMethods} {
+					for _, method := range list {
+						if !method.IsPackageLevel && method.Name == methodName {
+							method.Examples = append(method.Examples, example)
+							matched = true
+						}
+					}
+				}
+			}
+		} else if typ, ok := self.Types[typeName]; ok {
+			typ.Examples = append(typ.Examples, example)
+			matched = true
+		} else {
+			for _, fn := range self.Functions {
+				if fn.Name == typeName {
+					fn.Examples = append(fn.Examples, example)
+					matched = true
+				}
+			}
+
+			// constructors are listed with the type they return
+			for _, typ := range self.Types {
+				for _, method := range typ.Methods {
+					if method.IsPackageLevel && method.Name == typeName {
+						method.Examples = append(method.Examples, example)
+						matched = true
+					}
+				}
+			}
+		}
+
+		if !matched {
+			unmatched = append(unmatched, example)
+		}
+	}
+
+	return
+}
+
+// Warns about examples of declarations that don't exist (e.g.: misspelled, or since renamed), and
+// documents them with the package as a whole instead.
+func (self *Package) adoptUnmatchedExamples(examples []*Method) {
+	for _, example := range examples {
+		var diag = Diagnostic{
+			Severity: SeverityWarning,
+			Message:  fmt.Sprintf("%s refers to unknown identifier %s", example.Name, example.For),
+		}
+
+		if example.Position != nil {
+			diag.File = example.Position.File
+			diag.Line = example.Position.Line
+			diag.Column = example.Position.Column
+		}
+
+		self.addDiagnostics(diag)
+		example.For = ``
+	}
+}
+
+// Runs the examples of every package in the module with go test, recording whether each one
+// produced its expected output.  Examples without an output comment are only compiled by go test,
+// so they are left as they are.
+func (self *Module) runExamples(options *ScanOptions) {
+	self.Walk(func(pkg *Package) error {
+		for _, example := range pkg.Examples {
+			if example.ExpectedOutput != `` || example.EmptyOutput {
+				pkg.runExamples(options)
+				break
+			}
+		}
+
+		return nil
+	})
+}
+
+func (self *Package) runExamples(options *ScanOptions) {
+	var cmd = exec.Command(`go`, `test`, `-run`, `^Example`, `-v`, `.`)
+
+	cmd.Dir = self.dir
+	cmd.Env = goCommandEnv(options)
+
+	log.Infof("run examples: %s", self.dir)
+
+	var output, err = cmd.CombinedOutput()
+	var results = parseExampleResults(string(output))
+
+	// if nothing ran (e.g.: the tests don't build), every example that should have is a failure
+	if len(results) == 0 && err != nil {
+		self.addDiagnostics(Diagnostic{
+			Severity: SeverityError,
+			Message:  fmt.Sprintf("cannot run examples: %v: %s", err, strings.TrimSpace(string(output))),
+		})
+
+		for _, example := range self.Examples {
+			if example.ExpectedOutput != `` || example.EmptyOutput {
+				results[example.Name] = &exampleResult{}
+			}
+		}
+	}
+
+	for _, example := range self.Examples {
+		if result, ok := results[example.Name]; ok {
+			if result.passed {
+				example.Result = ExampleResultPassed
+				example.ActualOutput = example.ExpectedOutput
+			} else {
+				example.Result = ExampleResultFailed
+				example.ActualOutput = result.got
+			}
+		}
+	}
+}
+
+type exampleResult struct {
+	passed bool
+	got    string
+}
+
+// Reads the outcome of each example from the output of go test -v.  Failing examples are followed
+// by the output they produced ("got:") and the output that was expected ("want:").
+func parseExampleResults(output string) map[string]*exampleResult {
+	var results = make(map[string]*exampleResult)
+	var lines = strings.Split(output, "\n")
+
+	for i := 0; i < len(lines); i++ {
+		if match := rxExampleResult.FindStringSubmatch(lines[i]); match != nil {
+			var result = &exampleResult{
+				passed: (match[1] == `PASS`),
+			}
+
+			if !result.passed && i+1 < len(lines) && lines[i+1] == `got:` {
+				var got []string
+
+				for i += 2; i < len(lines) && lines[i] != `want:`; i++ {
+					got = append(got, lines[i])
+				}
+
+				if len(got) > 0 {
+					result.got = strings.Join(got, "\n") + "\n"
+				}
+			}
+
+			results[match[2]] = result
+		}
+	}
+
+	return results
+}
+
+// Returns the names of the examples in the module that didn't produce their expected output.
+func (self *Module) failedExamples() (failed []string) {
+	self.Walk(func(pkg *Package) error {
+		for _, example := range pkg.Examples {
+			if example.Result == ExampleResultFailed {
+				failed = append(failed, pkg.ImportPath+`.`+example.Name)
+			}
+		}
+
+		return nil
+	})
+
+	sort.Strings(failed)
+
+	return
+}
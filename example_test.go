@@ -0,0 +1,129 @@
+package main
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestSplitExampleName(t *testing.T) {
+	var cases = []struct {
+		name   string
+		forSym string
+		label  string
+	}{
+		{``, ``, ``},
+		{`_second`, ``, `second`},
+		{`F`, `F`, ``},
+		{`F_second`, `F`, `second`},
+		{`T_M`, `T.M`, ``},
+		{`T_M_second`, `T.M`, `second`},
+		{`T_m`, `T`, `m`},
+		{`T_Method_With_Underscores`, `T.Method_With_Underscores`, ``},
+	}
+
+	for _, c := range cases {
+		if forSym, label := splitExampleName(c.name); forSym != c.forSym || label != c.label {
+			t.Errorf("splitExampleName(%q) = %q, %q, want %q, %q", c.name, forSym, label, c.forSym, c.label)
+		}
+	}
+}
+
+func TestParseExampleResults(t *testing.T) {
+	var output = `=== RUN   ExampleHello
+--- PASS: ExampleHello (0.00s)
+=== RUN   ExampleT_M_second
+--- FAIL: ExampleT_M_second (0.00s)
+got:
+hello
+world
+want:
+hello
+=== RUN   ExampleEmpty
+--- FAIL: ExampleEmpty (0.00s)
+got:
+want:
+something
+FAIL
+exit status 1
+FAIL	example.com/test	0.004s
+`
+
+	var want = map[string]*exampleResult{
+		`ExampleHello`:      {passed: true},
+		`ExampleT_M_second`: {passed: false, got: "hello\nworld\n"},
+		`ExampleEmpty`:      {passed: false},
+	}
+
+	if got := parseExampleResults(output); !reflect.DeepEqual(got, want) {
+		for name, result := range got {
+			t.Logf("%s: %+v", name, *result)
+		}
+
+		t.Errorf("parseExampleResults() differs from %d expected results", len(want))
+	}
+}
+
+// Examples are attached to what they document; those documenting nothing are listed with the
+// package, with a warning.
+func TestExampleAssociation(t *testing.T) {
+	var pkg = scanTestModule(t, map[string]string{
+		`hello.go`: `package hello
+
+type Greeter struct{}
+
+func NewGreeter() *Greeter { return nil }
+
+func (g *Greeter) Greet() {}
+
+func Hello() {}
+`,
+		`hello_test.go`: `package hello_test
+
+func Example() {}
+
+func ExampleHello() {}
+
+func ExampleHello_loud() {}
+
+func ExampleGreeter() {}
+
+func ExampleNewGreeter() {}
+
+func ExampleGreeter_Greet() {}
+
+func ExampleGoodbye() {}
+`,
+	}, nil).Package
+
+	var greeter = pkg.Types[`Greeter`]
+	var counts = map[string]int{
+		`Hello`:      len(pkg.Functions[0].Examples),
+		`Greeter`:    len(greeter.Examples),
+		`NewGreeter`: 0,
+		`Greet`:      0,
+	}
+
+	for _, method := range greeter.Methods {
+		counts[method.Name] = len(method.Examples)
+	}
+
+	if want := map[string]int{`Hello`: 2, `Greeter`: 1, `NewGreeter`: 1, `Greet`: 1}; !reflect.DeepEqual(counts, want) {
+		t.Errorf("examples = %v, want %v", counts, want)
+	}
+
+	var unattached []string
+
+	for _, example := range pkg.Examples {
+		if example.For == `` {
+			unattached = append(unattached, example.Name)
+		}
+	}
+
+	if want := []string{`Example`, `ExampleGoodbye`}; !reflect.DeepEqual(unattached, want) {
+		t.Errorf("package examples = %q, want %q", unattached, want)
+	}
+
+	if len(pkg.Diagnostics) != 1 || pkg.Diagnostics[0].Severity != SeverityWarning {
+		t.Errorf("diagnostics = %v, want a warning about ExampleGoodbye", pkg.Diagnostics)
+	}
+}
@@ -7,31 +7,104 @@ import (
 	"go/ast"
 	"go/format"
 	"go/token"
+	"go/types"
 	"path/filepath"
-	"sort"
+	"strconv"
 	"strings"
 
 	"github.com/ghetzel/go-stockutil/log"
+	"github.com/ghetzel/go-stockutil/sliceutil"
 	"github.com/ghetzel/go-stockutil/stringutil"
 	"github.com/ghetzel/go-stockutil/typeutil"
 )
 
 const CommentExportedFields = `// contains filtered or unexported fields`
 
+// Struct tag keys that describe how a struct is serialized, in the order they are displayed.
+var SerializationTags = []string{`json`, `yaml`, `xml`, `toml`, `bson`, `msgpack`}
+
+// The location of a declaration in its package's source, from the start of its first line to the
+// end of its last.
+type Position struct {
+	File      string
+	Line      int
+	Column    int
+	EndLine   int
+	EndColumn int
+}
+
+// A piece of the source representation of a declaration, e.g.: of a function signature.  Tokens
+// naming a type (or other declaration) carry the import path of the package declaring it (empty for
+// the package being documented) and its name, and once the whole module has been scanned, the URL
+// documenting it.
+type Token struct {
+	Text       string
+	ImportPath string `json:",omitempty"`
+	Symbol     string `json:",omitempty"`
+	URL        string `json:",omitempty"`
+}
+
 // Represents a constant or variable declaration.
 type Value struct {
-	Name       string
-	Type       string `json:",omitempty"`
-	Immutable  bool   `json:",omitempty"`
-	Expression string `json:",omitempty"`
-	Value      string `json:",omitempty"`
-	Comment    string `json:",omitempty"`
+	Name         string
+	Type         string    `json:",omitempty"`
+	ResolvedType string    `json:",omitempty"`
+	Immutable    bool      `json:",omitempty"`
+	Expression   string    `json:",omitempty"`
+	Value        string    `json:",omitempty"`
+	Kind         string    `json:",omitempty"`
+	Comment      string    `json:",omitempty"`
+	CommentHTML  string    `json:",omitempty"`
+	Platforms    []string  `json:",omitempty"`
+	Exported     bool      `json:",omitempty"`
+	Position     *Position `json:",omitempty"`
+}
+
+// Represents a const or var declaration block, e.g.: const ( ... )
+type ValueGroup struct {
+	// Whether this is a const block.
+	Immutable bool `json:",omitempty"`
+
+	// The name of the type (declared in this package) that most of the values in this block are of.
+	TypeName string `json:",omitempty"`
+
+	// The comment text describing the block as a whole.
+	Comment string `json:",omitempty"`
+
+	// The comment, rendered as HTML.
+	CommentHTML string `json:",omitempty"`
+
+	// The values declared in this block, in the order they appear.
+	Values []Value
 }
 
 // Represents a function argument or return value.
 type Arg struct {
 	Name string `json:",omitempty"`
 	Type string
+
+	// The fully-qualified type, when packages are loaded with type information.
+	ResolvedType string `json:",omitempty"`
+
+	// The type, with the types it refers to linked to their documentation.
+	TypeTokens []Token `json:",omitempty"`
+
+	// Arguments that were declared together (e.g.: "a, b int") share the same group number.
+	Group int
+
+	resolved types.Type
+}
+
+// Represents a type parameter on a generic type or function declaration.
+type TypeParam struct {
+	Name       string
+	Constraint string `json:",omitempty"`
+
+	// The constraint, with the types it refers to linked to their documentation.
+	ConstraintTokens []Token `json:",omitempty"`
+
+	// Type parameters that were declared together (e.g.: "K, V any") share the same group number.
+	Group int
 }
 
 // Represents a function declaration, both package-level and struct methods.
@@ -54,15 +127,40 @@ type Method struct {
 	// The expected output of the method (for examples)
 	ExpectedOutput string `json:",omitempty"`
 
+	// The output the example produced when it was run, and whether it matched the expected output
+	// (ExampleResultPassed or ExampleResultFailed).  Both are empty unless examples were run.
+	ActualOutput string `json:",omitempty"`
+	Result       string `json:",omitempty"`
+
+	// Whether the expected output may appear in any order (for examples).
+	Unordered bool `json:",omitempty"`
+
+	// Whether the example expects no output at all, rather than having none to check.
+	EmptyOutput bool `json:",omitempty"`
+
+	// Whether Source is a complete file (for examples that rely on other declarations in their file),
+	// rather than the body of the function.
+	WholeFile bool `json:",omitempty"`
+
 	// The comment text describing the function.
 	Comment string `json:",omitempty"`
 
-	// Whether this method is attached to a Struct instance or Struct pointer.
+	// The comment, rendered as HTML.
+	CommentHTML string `json:",omitempty"`
+
+	// Whether this method is attached to a Struct instance or Struct pointer.  For promoted methods,
+	// whether the method is only in the method set of a pointer to the struct it's promoted to.
 	PointerReceiver bool `json:",omitempty"`
 
 	// The variable used to access the receiver instance.
 	ReceiverName string `json:",omitempty"`
 
+	// The names of the type parameters of a generic receiver (e.g.: T in "func (l *List[T]) Push()").
+	ReceiverTypeParams []string `json:",omitempty"`
+
+	// List of type parameters this (generic) function accepts.
+	TypeParams []TypeParam `json:",omitempty"`
+
 	// List of arguments this method accepts.
 	Arguments []Arg `json:",omitempty"`
 
@@ -72,31 +170,149 @@ type Method struct {
 	// Return a source representation of the function signature
 	Signature string `json:",omitempty"`
 
+	// The function signature, with the types it refers to linked to their documentation.
+	SignatureTokens []Token `json:",omitempty"`
+
 	// Optional full text of the function's source.
 	Source string `json:",omitempty"`
 
 	// Return whether this is a package-level function or struct method.
 	IsPackageLevel bool
+
+	// For promoted methods, the embedded field type this method was promoted from.
+	PromotedFrom string `json:",omitempty"`
+
+	// The platforms this function is declared for, if it isn't declared for all of them.
+	Platforms []string `json:",omitempty"`
+
+	// Whether the function's name is exported.
+	Exported bool `json:",omitempty"`
+
+	// Where the function is declared.
+	Position *Position `json:",omitempty"`
+
+	// Examples demonstrating this function.
+	Examples []*Method `json:",omitempty"`
+
+	receiverTypeName string
+}
+
+// Represents a single key:"value" pair from a struct field's tag.
+type FieldTag struct {
+	// The tag key, e.g.: "json"
+	Name string
+
+	// The unparsed tag value, e.g.: "name,omitempty"
+	Value string `json:",omitempty"`
+
+	// The name the field is known by in this tag, defaulting to the field name.
+	Key string `json:",omitempty"`
+
+	// Any comma-separated options that follow the key.
+	Options []string `json:",omitempty"`
+
+	// Whether the "omitempty" option is present.
+	OmitEmpty bool `json:",omitempty"`
+
+	// Whether the field is excluded entirely (e.g.: `json:"-"`).
+	Skip bool `json:",omitempty"`
 }
 
 // Represents a single field in a struct declaration.
 type Field struct {
-	Name    string
-	Type    string
-	Parent  *Type  `json:"-"`
-	Comment string `json:",omitempty"`
+	Name         string
+	Type         string
+	ResolvedType string               `json:",omitempty"`
+	TypeTokens   []Token              `json:",omitempty"`
+	Parent       *Type                `json:"-"`
+	Embedded     bool                 `json:",omitempty"`
+	Tag          string               `json:",omitempty"`
+	Tags         map[string]*FieldTag `json:",omitempty"`
+	Comment      string               `json:",omitempty"`
+	CommentHTML  string               `json:",omitempty"`
+	Exported     bool                 `json:",omitempty"`
+	Position     *Position            `json:",omitempty"`
+
+	// The platforms this field is declared for, if it isn't declared for all those its struct is.
+	Platforms []string `json:",omitempty"`
+
+	// Fields that were declared together (e.g.: "X, Y float64") share the same group number.  The
+	// first field of each group holds the names of the whole group, as they're declared.
+	Group      int
+	GroupNames string `json:",omitempty"`
+
+	resolved types.Type
+}
+
+// Parses the given struct tag literal into this field's Tags.
+func (self *Field) parseTag(lit *ast.BasicLit) {
+	if lit == nil {
+		return
+	}
+
+	if tag, err := strconv.Unquote(lit.Value); err == nil {
+		self.Tag = tag
+		self.Tags = make(map[string]*FieldTag)
+
+		for _, kv := range parseStructTag(tag) {
+			ft := &FieldTag{
+				Name:  kv[0],
+				Value: kv[1],
+			}
+
+			if ft.Value == `-` {
+				ft.Skip = true
+			} else {
+				options := strings.Split(ft.Value, `,`)
+				ft.Key = options[0]
+				ft.Options = options[1:]
+
+				if ft.Key == `` {
+					ft.Key = self.Name
+				}
+
+				for _, opt := range ft.Options {
+					if opt == `omitempty` {
+						ft.OmitEmpty = true
+					}
+				}
+			}
+
+			self.Tags[ft.Name] = ft
+		}
+	}
 }
 
 // Represents a type declaration, including all of its constituent fields and methods for structs.
 type Type struct {
 	File                *File `json:"-"`
 	Name                string
-	MetaType            string    `json:",omitempty"`
-	Methods             []*Method `json:",omitempty"`
-	Fields              []*Field  `json:",omitempty"`
-	Comment             string    `json:",omitempty"`
-	Source              string    `json:",omitempty"`
-	HasUnexportedFields bool      `json:",omitempty"`
+	MetaType            string        `json:",omitempty"`
+	TypeParams          []TypeParam   `json:",omitempty"`
+	Methods             []*Method     `json:",omitempty"`
+	Fields              []*Field      `json:",omitempty"`
+	PromotedMethods     []*Method     `json:",omitempty"`
+	Constants           []*ValueGroup `json:",omitempty"`
+	Variables           []*ValueGroup `json:",omitempty"`
+	SerializedFormats   []string      `json:",omitempty"`
+	InterfaceMethods    []*Method     `json:",omitempty"`
+	EmbeddedInterfaces  []string      `json:",omitempty"`
+	TypeSets            []string      `json:",omitempty"`
+	Comment             string        `json:",omitempty"`
+	CommentHTML         string        `json:",omitempty"`
+	Source              string        `json:",omitempty"`
+	HasUnexportedFields bool          `json:",omitempty"`
+	Platforms           []string      `json:",omitempty"`
+	Exported            bool          `json:",omitempty"`
+	Position            *Position     `json:",omitempty"`
+
+	// The interfaces in the module this type implements (for concrete types), or the types in the
+	// module implementing it (for interfaces).  Only known when packages are loaded with type information.
+	Implements    []TypeReference `json:",omitempty"`
+	ImplementedBy []TypeReference `json:",omitempty"`
+
+	// Examples demonstrating this type.
+	Examples []*Method `json:",omitempty"`
 }
 
 // Represents an import declaration for a dependent package.
@@ -118,6 +334,7 @@ type File struct {
 	TypeCount       int
 	ConstantCount   int
 	VariableCount   int
+	Platforms       []string `json:",omitempty"`
 	ast             *ast.File
 }
 
@@ -149,42 +366,13 @@ func (self *File) parse() error {
 		case *ast.GenDecl:
 			gen := decl.(*ast.GenDecl)
 
+			if gen.Tok == token.CONST || gen.Tok == token.VAR {
+				self.appendValueDecl(gen)
+				continue
+			}
+
 			for _, spec := range gen.Specs {
 				switch spec.(type) {
-				case *ast.ValueSpec: // consts and vars
-					vspec := spec.(*ast.ValueSpec)
-					value := Value{
-						Name:    vspec.Names[0].String(),
-						Type:    astTypeToString(vspec.Type),
-						Comment: formatAstComment(gen.Doc),
-					}
-
-					for _, val := range vspec.Values {
-						if expr := strings.TrimSpace(mustAstNodeToString(val)); len(expr) <= MaxExpressionSnippetLength {
-							value.Expression = expr
-
-							// I'm certain there's a better way to do this in go/<somewhere>, but am not up for finding it now.
-							// TODO: make this more-better
-							if stringutil.IsSurroundedBy(expr, "`", "`") {
-								value.Value = stringutil.Unwrap(expr, "`", "`")
-							} else if stringutil.IsSurroundedBy(expr, `"`, `"`) {
-								value.Value = stringutil.Unwrap(expr, `"`, `"`)
-							}
-						}
-
-						break
-					}
-
-					switch gen.Tok {
-					case token.CONST:
-						value.Immutable = true
-						self.Package.Constants = append(self.Package.Constants, value)
-						self.ConstantCount += 1
-					case token.VAR:
-						self.Package.Variables = append(self.Package.Variables, value)
-						self.VariableCount += 1
-					}
-
 				case *ast.TypeSpec: // type declarations
 					self.appendTypeDecl(gen, spec.(*ast.TypeSpec))
 				}
@@ -197,165 +385,315 @@ func (self *File) parse() error {
 	return nil
 }
 
-func (self *File) appendFuncDecl(fn *ast.FuncDecl) {
-	method := new(Method)
-	method.File = self
-	method.Name = fn.Name.Name
-	method.Comment = formatAstComment(fn.Doc)
+// Returns where the given node appears in this file, if the file set it was parsed into is known.
+func (self *File) position(node ast.Node) *Position {
+	if self.Package == nil || self.Package.fset == nil {
+		return nil
+	}
 
-	if method.Name == `main` {
-		self.MainFunction = true
+	var start = self.Package.fset.Position(node.Pos())
+	var end = self.Package.fset.Position(node.End())
+
+	return &Position{
+		File:      self.Name,
+		Line:      start.Line,
+		Column:    start.Column,
+		EndLine:   end.Line,
+		EndColumn: end.Column,
 	}
+}
 
-	if ast.IsExported(method.Name) {
-		var constructorTypeName string
+// Adds a const or var declaration block to the package, retaining its original grouping.
+func (self *File) appendValueDecl(gen *ast.GenDecl) {
+	var group = &ValueGroup{
+		Immutable: (gen.Tok == token.CONST),
+		TypeName:  valueDeclTypeName(gen),
+		Comment:   formatAstComment(gen.Doc),
+		Values:    make([]Value, 0),
+	}
+
+	for _, spec := range gen.Specs {
+		vspec, ok := spec.(*ast.ValueSpec)
 
-		// TODO: make this anon function a package-level exported function
-		defer func(m *Method) {
-			var argset []string
-			var retset []string
+		if !ok {
+			continue
+		}
 
-			for _, arg := range m.Arguments {
-				argset = append(argset, fmt.Sprintf("%v %v", arg.Name, arg.Type))
+		for i, name := range vspec.Names {
+			if !self.Package.documents(name.Name) {
+				continue
 			}
 
-			for _, ret := range m.Returns {
-				if ret.Name != `` {
-					retset = append(retset, fmt.Sprintf("%v %v", ret.Name, ret.Type))
-				} else {
-					retset = append(retset, ret.Type)
-				}
+			value := Value{
+				Name:         name.String(),
+				Type:         astTypeToString(vspec.Type),
+				ResolvedType: resolvedTypeString(self.Package.typeOf(name)),
+				Immutable:    group.Immutable,
+				Comment:      formatAstComment(vspec.Doc),
+				Platforms:    self.Platforms,
+				Exported:     ast.IsExported(name.Name),
+				Position:     self.position(spec),
 			}
 
-			m.Signature = m.Name + `(` + strings.Join(argset, `, `) + `)`
+			// a lone declaration spans the whole of it, keyword and all
+			if !gen.Lparen.IsValid() {
+				value.Position = self.position(gen)
+			}
 
-			switch len(retset) {
-			case 0:
-				return
-			case 1:
-				m.Signature += ` ` + retset[0]
-			default:
-				m.Signature += ` (` + strings.Join(retset, `, `) + `)`
+			if value.Comment == `` {
+				value.Comment = formatAstComment(vspec.Comment)
 			}
-		}(method)
 
-		for _, param := range fn.Type.Params.List {
-			var name string
+			// a lone declaration's doc comment describes the value itself
+			if value.Comment == `` && !gen.Lparen.IsValid() {
+				value.Comment = group.Comment
+			}
 
-			if len(param.Names) > 0 {
-				name = param.Names[0].String()
+			// each name is paired with its own value (e.g.: const A, B = 1, 2); a single
+			// multi-valued expression (e.g.: var a, b = f()) can't be split up per-name.
+			if len(vspec.Values) == len(vspec.Names) {
+				if expr, err := astNodeToString(vspec.Values[i]); err == nil {
+					if expr = strings.TrimSpace(expr); len(expr) <= MaxExpressionSnippetLength {
+						value.Expression = expr
+					}
+				} else {
+					self.skipDecl(vspec, err)
+					continue
+				}
 			}
 
-			method.Arguments = append(method.Arguments, Arg{
-				Name: name,
-				Type: astTypeToString(param.Type),
-			})
-		}
+			group.Values = append(group.Values, value)
 
-		if fn.Type.Results != nil {
-			for i, res := range fn.Type.Results.List {
-				var name string
+			if group.Immutable {
+				self.ConstantCount += 1
+			} else {
+				self.VariableCount += 1
+			}
+		}
+	}
 
-				if len(res.Names) > 0 {
-					name = res.Names[0].String()
-				}
+	if len(group.Values) > 0 {
+		self.Package.valueGroups = append(self.Package.valueGroups, group)
+	}
+}
 
-				retType := astTypeToString(res.Type)
+func (self *File) appendFuncDecl(fn *ast.FuncDecl) {
+	method := new(Method)
+	method.File = self
+	method.Name = fn.Name.Name
+	method.Platforms = self.Platforms
+	method.Comment = formatAstComment(fn.Doc)
+	method.Exported = ast.IsExported(method.Name)
+	method.Position = self.position(fn)
 
-				method.Returns = append(method.Returns, Arg{
-					Name: name,
-					Type: retType,
-				})
+	if method.Name == `main` {
+		self.MainFunction = true
+	}
 
-				if t := self.describesDeclaredType(retType); i == 0 && t != `` {
-					constructorTypeName = t
-				}
-			}
-		}
+	// init functions can't be referred to (and there may be several of them)
+	if fn.Recv == nil && method.Name == `init` {
+		return
+	}
 
+	if self.Package.documents(method.Name) {
 		// no receiver == package-level function
 		if fn.Recv == nil {
 			method.IsPackageLevel = true
-			method.Source = base64.StdEncoding.EncodeToString(
-				[]byte(mustAstNodeToString(fn.Body)),
-			)
 
-			// ...except if it's first return argument type has been declared as a struct
-			// in this package.  If so, we put it with that struct's methods
-			if typ, ok := self.Package.Types[constructorTypeName]; ok {
-				typ.Methods = append(typ.Methods, method)
+			// functions implemented elsewhere (e.g.: in assembly) have no body
+			if fn.Body != nil {
+				if body, err := astNodeToString(fn.Body); err == nil {
+					method.Source = base64.StdEncoding.EncodeToString([]byte(body))
+				} else {
+					self.skipDecl(fn, err)
+					return
+				}
+			}
 
-				// NOTE: this still counts as a package-level function even through we're putting it in a type
-				self.FunctionCount += 1
-			} else if strings.HasPrefix(method.Name, `Test`) {
-				self.Package.Tests = append(self.Package.Tests, method)
-			} else if strings.HasPrefix(method.Name, `Example`) {
-				pair := strings.TrimPrefix(method.Name, `Example`)
-				method.For, method.Label = stringutil.SplitPair(pair, `_`)
-				method.Label = stringutil.Camelize(method.Label)
-
-				self.Package.Examples = append(self.Package.Examples, method)
-			} else {
-				self.Package.Functions = append(self.Package.Functions, method)
+			// NOTE: constructors still count as package-level functions, even though they're placed with their type
+			if !strings.HasPrefix(method.Name, `Test`) && !strings.HasPrefix(method.Name, `Example`) {
 				self.FunctionCount += 1
 			}
-
-			return
 		} else if len(fn.Recv.List) > 0 {
 			var listField = fn.Recv.List[len(fn.Recv.List)-1]
 			var listFieldType = listField.Type
-			var ident *ast.Ident
 
 			if len(listField.Names) > 0 {
 				method.ReceiverName = listField.Names[0].Name
 			}
 
-			switch listFieldType.(type) {
-			case *ast.StarExpr: // e.g.: func (doc *Whatever) Hello()
+			// e.g.: func (doc *Whatever) Hello()
+			if star, ok := listFieldType.(*ast.StarExpr); ok {
 				method.PointerReceiver = true
-				ident = listFieldType.(*ast.StarExpr).X.(*ast.Ident)
-			case *ast.Ident: // e.g.: func (doc Whatever) Hello()
-				ident = listFieldType.(*ast.Ident)
+				listFieldType = star.X
 			}
 
-			if ident != nil {
-				if recvName := ident.Name; ast.IsExported(recvName) {
-					var parent *Type = self.Package.Types[recvName]
+			ident, typeParams := astReceiverTypeIdent(listFieldType)
+			method.ReceiverTypeParams = typeParams
 
-					if parent == nil {
-						parent = new(Type)
-						parent.Name = recvName
-						parent.MetaType = `struct`
-					}
+			if ident == nil || !self.Package.documents(ident.Name) {
+				return
+			}
 
-					parent.Methods = append(parent.Methods, method)
-					self.FunctionCount += 1
+			method.receiverTypeName = ident.Name
+			self.FunctionCount += 1
+		} else {
+			return
+		}
 
-					sort.Slice(parent.Methods, func(i int, j int) bool {
-						return parent.Methods[i].Name < parent.Methods[j].Name
-					})
+		// the receiver's type parameters are in scope in the signature
+		method.parseFuncType(fn.Type, method.ReceiverTypeParams)
 
-					self.Package.Types[recvName] = parent
-				} else {
-					return
-				}
+		// which type (if any) this function belongs to is decided once the whole package has been read
+		self.Package.funcs = append(self.Package.funcs, method)
+	}
+}
+
+// Populates the type parameters, arguments, return values and signature from the given function type.
+// The names of the type parameters in scope around it (those of a generic receiver or interface) are
+// given so that they aren't mistaken for declared types.
+func (self *Method) parseFuncType(fn *ast.FuncType, outerTypeParams []string) {
+	var typeParams = append(typeParamNames(fn.TypeParams), outerTypeParams...)
+
+	self.TypeParams = astFieldListToTypeParams(fn.TypeParams, self.File)
+	self.Arguments = astFieldListToArgs(fn.Params, self.File, typeParams)
+	self.Returns = astFieldListToArgs(fn.Results, self.File, typeParams)
+	self.SignatureTokens = methodSignatureTokens(self)
+	self.Signature = tokensToString(self.SignatureTokens)
+}
+
+// Expands a parameter or result list into one Arg per declared name.
+func astFieldListToArgs(list *ast.FieldList, file *File, typeParams []string) (args []Arg) {
+	if list != nil {
+		for group, field := range list.List {
+			tokens := astTypeToTokens(field.Type, file, typeParams...)
+			typ := tokensToString(tokens)
+			resolved := file.Package.typeOf(field.Type)
+
+			if len(field.Names) == 0 {
+				args = append(args, Arg{
+					Type:         typ,
+					ResolvedType: resolvedTypeString(resolved),
+					TypeTokens:   tokens,
+					Group:        group,
+					resolved:     resolved,
+				})
+			}
+
+			for _, name := range field.Names {
+				args = append(args, Arg{
+					Name:         name.String(),
+					Type:         typ,
+					ResolvedType: resolvedTypeString(resolved),
+					TypeTokens:   tokens,
+					Group:        group,
+					resolved:     resolved,
+				})
+			}
+		}
+	}
+
+	return
+}
+
+// Return a source representation of the given method's signature, e.g.: "Get(key string) (string, error)"
+func MethodSignature(m *Method) string {
+	return tokensToString(methodSignatureTokens(m))
+}
+
+// Returns the tokens of the given method's signature.
+func methodSignatureTokens(m *Method) []Token {
+	var signature = newTokenizer(nil)
+
+	signature.write(m.Name)
+
+	if len(m.TypeParams) > 0 {
+		signature.write(`[`)
+
+		for i, tp := range m.TypeParams {
+			if i > 0 {
+				signature.write(`, `)
+			}
+
+			if i+1 < len(m.TypeParams) && m.TypeParams[i+1].Group == tp.Group {
+				signature.write(tp.Name)
+			} else {
+				signature.write(tp.Name + ` `)
+				signature.append(tp.ConstraintTokens, tp.Constraint)
 			}
 		}
+
+		signature.write(`]`)
+	}
+
+	signature.write(`(`)
+	signature.args(m.Arguments)
+	signature.write(`)`)
+
+	switch len(m.Returns) {
+	case 0:
+		break
+	case 1:
+		if m.Returns[0].Name == `` {
+			signature.write(` `)
+			signature.args(m.Returns)
+			break
+		}
+
+		fallthrough
+	default:
+		signature.write(` (`)
+		signature.args(m.Returns)
+		signature.write(`)`)
+	}
+
+	return signature.tokens
+}
+
+// Appends a list of arguments, collapsing names that were declared together (e.g.: "a, b int").
+func (self *tokenizer) args(args []Arg) {
+	for i, arg := range args {
+		if i > 0 {
+			self.write(`, `)
+		}
+
+		if arg.Name == `` {
+			self.append(arg.TypeTokens, arg.Type)
+		} else if i+1 < len(args) && args[i+1].Group == arg.Group {
+			self.write(arg.Name)
+		} else {
+			self.write(arg.Name + ` `)
+			self.append(arg.TypeTokens, arg.Type)
+		}
 	}
 }
 
 func (self *File) appendTypeDecl(meta *ast.GenDecl, tspec *ast.TypeSpec) {
-	if name := tspec.Name.Name; ast.IsExported(name) {
+	if name := tspec.Name.Name; self.Package.documents(name) {
 		var typ = new(Type)
+		var src, err = astNodeToString(meta)
 
-		if s, ok := self.Package.Types[name]; ok {
-			typ = s
-		} else {
-			typ = new(Type)
+		if err != nil {
+			self.skipDecl(tspec, err)
+			return
 		}
 
+		typ.File = self
+		typ.Platforms = self.Platforms
 		typ.Name = name
-		src := mustAstNodeToString(meta)
+		typ.Exported = ast.IsExported(name)
+		typ.Position = self.position(tspec)
+
+		if !meta.Lparen.IsValid() {
+			typ.Position = self.position(meta)
+		}
+		typ.TypeParams = astFieldListToTypeParams(tspec.TypeParams, self)
+		typ.Comment = formatAstComment(tspec.Doc)
+
+		// the comment above an ungrouped declaration (or a whole group) is attached to the "type" keyword
+		if typ.Comment == `` {
+			typ.Comment = formatAstComment(meta.Doc)
+		}
 
 		if strings.Contains(src, CommentExportedFields) {
 			src = strings.ReplaceAll(src, CommentExportedFields, ``)
@@ -369,24 +707,128 @@ func (self *File) appendTypeDecl(meta *ast.GenDecl, tspec *ast.TypeSpec) {
 		case *ast.StructType:
 			strct := tspec.Type.(*ast.StructType)
 			typ.MetaType = `struct`
-			typ.Comment = formatAstComment(meta.Doc)
 			typ.Fields = make([]*Field, 0)
 
-			for _, field := range strct.Fields.List {
-				if len(field.Names) > 0 {
-					if fieldName := field.Names[0].String(); ast.IsExported(fieldName) {
-						typ.Fields = append(typ.Fields, &Field{
-							Name:    fieldName,
-							Type:    astTypeToString(field.Type),
-							Comment: formatAstComment(field.Doc),
+			for group, field := range strct.Fields.List {
+				var fields []*Field
+				var names []string
+				var comment = formatAstComment(field.Doc)
+
+				// fall back to the comment at the end of the line, e.g.: X int // the X coordinate
+				if comment == `` {
+					comment = formatAstComment(field.Comment)
+				}
+
+				for _, name := range field.Names {
+					if fieldName := name.String(); self.Package.documents(fieldName) {
+						names = append(names, fieldName)
+						fields = append(fields, &Field{
+							Name:      fieldName,
+							Type:      astTypeToString(field.Type),
+							Parent:    typ,
+							Comment:   comment,
+							Platforms: self.Platforms,
+							Exported:  ast.IsExported(fieldName),
 						})
 					}
 				}
+
+				if len(field.Names) == 0 {
+					if fieldName := embeddedFieldName(field.Type); self.Package.documents(fieldName) {
+						// embedded fields are named after their (unqualified) type
+						fields = append(fields, &Field{
+							Name:      fieldName,
+							Type:      astTypeToString(field.Type),
+							Parent:    typ,
+							Embedded:  true,
+							Comment:   comment,
+							Platforms: self.Platforms,
+							Exported:  ast.IsExported(fieldName),
+						})
+					}
+				}
+
+				for _, f := range fields {
+					f.TypeTokens = astTypeToTokens(field.Type, self, typeParamNames(tspec.TypeParams)...)
+					f.Position = self.position(field)
+					f.resolved = self.Package.typeOf(field.Type)
+					f.ResolvedType = resolvedTypeString(f.resolved)
+					f.parseTag(field.Tag)
+					f.Group = group
+					typ.Fields = append(typ.Fields, f)
+				}
+
+				if len(names) > 0 {
+					fields[0].GroupNames = strings.Join(names, `, `)
+				}
+			}
+
+			// note which serialization formats this struct has been annotated for (unexported fields
+			// aren't serialized, whatever their tags say)
+			for _, format := range SerializationTags {
+				for _, f := range typ.Fields {
+					if _, ok := f.Tags[format]; ok && f.Exported {
+						typ.SerializedFormats = append(typ.SerializedFormats, format)
+						break
+					}
+				}
+			}
+		case *ast.InterfaceType:
+			iface := tspec.Type.(*ast.InterfaceType)
+			typ.MetaType = `interface`
+			typ.InterfaceMethods = make([]*Method, 0)
+
+			for _, field := range iface.Methods.List {
+				switch field.Type.(type) {
+				case *ast.FuncType: // e.g.: Close() error
+					if len(field.Names) > 0 && self.Package.documents(field.Names[0].Name) {
+						spec := new(Method)
+						spec.File = self
+						spec.Parent = typ
+						spec.Name = field.Names[0].Name
+						spec.Platforms = self.Platforms
+						spec.Exported = ast.IsExported(spec.Name)
+						spec.Position = self.position(field)
+						spec.Comment = formatAstComment(field.Doc)
+
+						if spec.Comment == `` {
+							spec.Comment = formatAstComment(field.Comment)
+						}
+
+						// the interface's type parameters are in scope in the method's signature
+						spec.parseFuncType(field.Type.(*ast.FuncType), typeParamNames(tspec.TypeParams))
+
+						typ.InterfaceMethods = append(typ.InterfaceMethods, spec)
+					}
+				case *ast.Ident, *ast.SelectorExpr, *ast.IndexExpr, *ast.IndexListExpr: // e.g.: io.Reader
+					var isTypeSet bool
+
+					// without type information, only predeclared types can be told apart from interfaces
+					if t := self.Package.typeOf(field.Type); t != nil {
+						isTypeSet = !types.IsInterface(t)
+					} else {
+						isTypeSet = isBasicTypeName(field.Type)
+					}
+
+					if isTypeSet {
+						typ.TypeSets = append(typ.TypeSets, astTypeToString(field.Type))
+					} else {
+						typ.EmbeddedInterfaces = append(typ.EmbeddedInterfaces, astTypeToString(field.Type))
+					}
+				default: // e.g.: ~int | ~string
+					typ.TypeSets = append(typ.TypeSets, astTypeToString(field.Type))
+				}
 			}
 		case *ast.Ident:
 			typ.MetaType = typeutil.String(tspec.Type)
-		default:
-			// log.Dump(`typedecl: unhandled metatype: `, tspec.Type)
+		default: // e.g.: named slice, map, and func types
+			typ.MetaType = astTypeToString(tspec.Type)
+		}
+
+		// the same type declared for another platform (e.g.: in foo_linux.go and foo_windows.go)
+		if s, ok := self.Package.Types[name]; ok && s.File != nil {
+			s.merge(typ, self.Package)
+			return
 		}
 
 		self.Package.Types[name] = typ
@@ -394,41 +836,538 @@ func (self *File) appendTypeDecl(meta *ast.GenDecl, tspec *ast.TypeSpec) {
 	}
 }
 
-func (self *File) describesDeclaredType(typestr string) string {
-	for typeName, _ := range self.Package.Types {
-		typestr = strings.TrimPrefix(typestr, `*`)
+// Merges another declaration of this type (made for other platforms) into this one.  Fields and
+// interface methods are matched by name, and those not declared for every platform the type is
+// declared for are marked with the platforms that do declare them.
+func (self *Type) merge(other *Type, pkg *Package) {
+	var group int
+
+	self.Platforms = pkg.mergePlatforms(self.Platforms, other.Platforms)
+	self.HasUnexportedFields = self.HasUnexportedFields || other.HasUnexportedFields
+
+	for _, field := range self.Fields {
+		if field.Group >= group {
+			group = field.Group + 1
+		}
+	}
+
+	var fields = make(map[string]*Field)
+	var methods = make(map[string]*Method)
+
+	for _, field := range self.Fields {
+		fields[field.Name] = field
+	}
+
+	for _, method := range self.InterfaceMethods {
+		methods[method.Name] = method
+	}
+
+	for _, field := range other.Fields {
+		if existing, ok := fields[field.Name]; ok {
+			existing.Platforms = pkg.mergePlatforms(existing.Platforms, field.Platforms)
+		} else {
+			// listed on a line of its own, after the fields of the first declaration
+			field.Parent = self
+			field.Group = group
+			field.GroupNames = field.Name
+			self.Fields = append(self.Fields, field)
+			group += 1
+		}
+	}
+
+	for _, method := range other.InterfaceMethods {
+		if existing, ok := methods[method.Name]; ok {
+			existing.Platforms = pkg.mergePlatforms(existing.Platforms, method.Platforms)
+		} else {
+			method.Parent = self
+			self.InterfaceMethods = append(self.InterfaceMethods, method)
+		}
+	}
+
+	for _, format := range other.SerializedFormats {
+		if !sliceutil.ContainsString(self.SerializedFormats, format) {
+			self.SerializedFormats = append(self.SerializedFormats, format)
+		}
+	}
+}
+
+// Splits a struct tag into its key/value pairs, in the order they appear.  This follows the
+// same conventions as reflect.StructTag.Lookup.
+func parseStructTag(tag string) (pairs [][2]string) {
+	for tag != `` {
+		i := 0
+
+		for i < len(tag) && tag[i] == ' ' {
+			i++
+		}
+
+		tag = tag[i:]
+
+		if tag == `` {
+			break
+		}
+
+		i = 0
+
+		for i < len(tag) && tag[i] > ' ' && tag[i] != ':' && tag[i] != '"' && tag[i] != 0x7f {
+			i++
+		}
+
+		if i == 0 || i+1 >= len(tag) || tag[i] != ':' || tag[i+1] != '"' {
+			break
+		}
+
+		name := tag[:i]
+		tag = tag[i+1:]
+		i = 1
+
+		for i < len(tag) && tag[i] != '"' {
+			if tag[i] == '\\' {
+				i++
+			}
+
+			i++
+		}
+
+		if i >= len(tag) {
+			break
+		}
+
+		if value, err := strconv.Unquote(tag[:i+1]); err == nil {
+			pairs = append(pairs, [2]string{name, value})
+		} else {
+			break
+		}
+
+		tag = tag[i+1:]
+	}
+
+	return
+}
+
+// Determines which type a const or var block should be documented with, following the same rules
+// as go doc: the block must be predominantly of a single, locally-declared named type.  Constants
+// with no type or value (e.g.: in an iota block) take on the type of the preceding line.
+func valueDeclTypeName(gen *ast.GenDecl) string {
+	var domName string
+	var domFreq int
+	var prev string
 
-		if typeName == typestr {
-			return typeName
+	for _, spec := range gen.Specs {
+		vspec, ok := spec.(*ast.ValueSpec)
+
+		if !ok {
+			continue
+		}
+
+		var name string
+
+		if vspec.Type != nil {
+			if _, imported := vspec.Type.(*ast.SelectorExpr); !imported {
+				name = embeddedFieldName(vspec.Type)
+			}
+		} else if gen.Tok == token.CONST && len(vspec.Values) == 0 {
+			name = prev
 		}
+
+		if name != `` {
+			if domName != `` && domName != name {
+				return ``
+			}
+
+			domName = name
+			domFreq += 1
+		}
+
+		prev = name
+	}
+
+	if domName != `` && domFreq >= int(float64(len(gen.Specs))*0.75) {
+		return domName
 	}
 
 	return ``
 }
 
+// Returns the implicit field name of an embedded field type, e.g.: "Reader" for "*io.Reader"
+func embeddedFieldName(expr ast.Expr) string {
+	switch expr.(type) {
+	case *ast.StarExpr:
+		return embeddedFieldName(expr.(*ast.StarExpr).X)
+	case *ast.SelectorExpr:
+		return expr.(*ast.SelectorExpr).Sel.Name
+	case *ast.IndexExpr:
+		return embeddedFieldName(expr.(*ast.IndexExpr).X)
+	case *ast.IndexListExpr:
+		return embeddedFieldName(expr.(*ast.IndexListExpr).X)
+	case *ast.Ident:
+		return expr.(*ast.Ident).Name
+	default:
+		return ``
+	}
+}
+
+// Returns whether the given expression names a predeclared non-interface type (e.g.: int, string),
+// which in an interface declaration is a type set term rather than an embedded interface.
+func isBasicTypeName(expr ast.Expr) bool {
+	if ident, ok := expr.(*ast.Ident); ok {
+		if obj, ok := types.Universe.Lookup(ident.Name).(*types.TypeName); ok {
+			return !types.IsInterface(obj.Type())
+		}
+	}
+
+	return false
+}
+
+// Returns the source representation of the given type expression, formatted the
+// same way gofmt would print it on a single line.
 func astTypeToString(typ ast.Expr) string {
+	return tokensToString(astTypeToTokens(typ, nil))
+}
+
+// Returns the tokens of the given type expression.  Identifiers referring to declarations are
+// resolved against the given file (if any), except for the names of type parameters in scope.
+func astTypeToTokens(typ ast.Expr, file *File, typeParams ...string) []Token {
+	var tokens = newTokenizer(file, typeParams...)
+
+	tokens.expr(typ)
+
+	return tokens.tokens
+}
+
+// Accumulates the tokens of type expressions.
+type tokenizer struct {
+	file       *File
+	typeParams map[string]bool
+	tokens     []Token
+}
+
+func newTokenizer(file *File, typeParams ...string) *tokenizer {
+	var tokens = &tokenizer{
+		file:       file,
+		typeParams: make(map[string]bool),
+	}
+
+	for _, name := range typeParams {
+		tokens.typeParams[name] = true
+	}
+
+	return tokens
+}
+
+// Appends plain text, merging it into the preceding token if that is plain text as well.
+func (self *tokenizer) write(text string) {
+	if n := len(self.tokens); n > 0 && self.tokens[n-1].Symbol == `` {
+		self.tokens[n-1].Text += text
+	} else if text != `` {
+		self.tokens = append(self.tokens, Token{
+			Text: text,
+		})
+	}
+}
+
+// Appends previously tokenized text, or the given text if there are no tokens.
+func (self *tokenizer) append(tokens []Token, text string) {
+	if len(tokens) == 0 {
+		self.write(text)
+		return
+	}
+
+	for _, token := range tokens {
+		if token.Symbol == `` {
+			self.write(token.Text)
+		} else {
+			self.tokens = append(self.tokens, token)
+		}
+	}
+}
+
+func (self *tokenizer) ident(ident *ast.Ident) {
+	var name = ident.String()
+
+	if self.file == nil || self.typeParams[name] || types.Universe.Lookup(name) != nil {
+		self.write(name)
+	} else {
+		self.tokens = append(self.tokens, Token{
+			Text:   name,
+			Symbol: name,
+		})
+	}
+}
+
+func (self *tokenizer) selector(sel *ast.SelectorExpr) {
+	if x, ok := sel.X.(*ast.Ident); ok && self.file != nil {
+		for _, imp := range self.file.Imports {
+			// cgo's pseudo-package isn't documented anywhere
+			if imp.Alias == x.Name && imp.PackageName != `C` {
+				self.tokens = append(self.tokens, Token{
+					Text:       x.Name + `.` + sel.Sel.String(),
+					ImportPath: imp.PackageName,
+					Symbol:     sel.Sel.String(),
+				})
+
+				return
+			}
+		}
+	}
+
+	self.write(astTypeToString(sel.X) + `.` + sel.Sel.String())
+}
+
+func (self *tokenizer) expr(typ ast.Expr) {
 	switch typ.(type) {
+	case nil:
+		return
 	case *ast.Ident:
-		return typ.(*ast.Ident).String()
+		self.ident(typ.(*ast.Ident))
+	case *ast.BasicLit: // e.g.: the 4 in [4]byte
+		self.write(typ.(*ast.BasicLit).Value)
 	case *ast.ArrayType:
-		return `[]` + astTypeToString(typ.(*ast.ArrayType).Elt)
+		at := typ.(*ast.ArrayType)
+		self.write(`[`)
+		self.expr(at.Len)
+		self.write(`]`)
+		self.expr(at.Elt)
 	case *ast.StarExpr:
-		return `*` + astTypeToString(typ.(*ast.StarExpr).X)
+		self.write(`*`)
+		self.expr(typ.(*ast.StarExpr).X)
+	case *ast.ParenExpr:
+		self.write(`(`)
+		self.expr(typ.(*ast.ParenExpr).X)
+		self.write(`)`)
 	case *ast.MapType:
 		mt := typ.(*ast.MapType)
-		return `map[` + astTypeToString(mt.Key) + `]` + astTypeToString(mt.Value)
+		self.write(`map[`)
+		self.expr(mt.Key)
+		self.write(`]`)
+		self.expr(mt.Value)
+	case *ast.ChanType:
+		ct := typ.(*ast.ChanType)
+
+		switch ct.Dir {
+		case ast.SEND:
+			self.write(`chan<- `)
+		case ast.RECV:
+			self.write(`<-chan `)
+		default:
+			self.write(`chan `)
+		}
+
+		self.expr(ct.Value)
+	case *ast.FuncType:
+		self.write(`func`)
+		self.funcType(typ.(*ast.FuncType))
 	case *ast.SelectorExpr:
-		sel := typ.(*ast.SelectorExpr)
-		return typeutil.String(sel.X) + `.` + sel.Sel.String()
+		self.selector(typ.(*ast.SelectorExpr))
+	case *ast.StructType:
+		fields := typ.(*ast.StructType).Fields.List
+
+		if len(fields) == 0 {
+			self.write(`struct{}`)
+			return
+		}
+
+		self.write(`struct{ `)
+
+		for i, field := range fields {
+			if i > 0 {
+				self.write(`; `)
+			}
+
+			self.field(field)
+
+			if field.Tag != nil {
+				self.write(` ` + field.Tag.Value)
+			}
+		}
+
+		self.write(` }`)
 	case *ast.InterfaceType:
-		return `interface{}`
+		elements := typ.(*ast.InterfaceType).Methods.List
+
+		if len(elements) == 0 {
+			self.write(`interface{}`)
+			return
+		}
+
+		self.write(`interface{ `)
+
+		for i, field := range elements {
+			if i > 0 {
+				self.write(`; `)
+			}
+
+			if ft, ok := field.Type.(*ast.FuncType); ok && len(field.Names) > 0 {
+				self.write(field.Names[0].String())
+				self.funcType(ft)
+			} else {
+				self.expr(field.Type)
+			}
+		}
+
+		self.write(` }`)
 	case *ast.Ellipsis:
-		return `...` + astTypeToString(typ.(*ast.Ellipsis).Elt)
+		self.write(`...`)
+		self.expr(typ.(*ast.Ellipsis).Elt)
+	case *ast.IndexExpr: // e.g.: List[T]
+		ie := typ.(*ast.IndexExpr)
+		self.expr(ie.X)
+		self.write(`[`)
+		self.expr(ie.Index)
+		self.write(`]`)
+	case *ast.IndexListExpr: // e.g.: Map[K, V]
+		ile := typ.(*ast.IndexListExpr)
+		self.expr(ile.X)
+		self.write(`[`)
+
+		for i, index := range ile.Indices {
+			if i > 0 {
+				self.write(`, `)
+			}
+
+			self.expr(index)
+		}
+
+		self.write(`]`)
+	case *ast.UnaryExpr: // e.g.: ~int
+		ue := typ.(*ast.UnaryExpr)
+		self.write(ue.Op.String())
+		self.expr(ue.X)
+	case *ast.BinaryExpr:
+		be := typ.(*ast.BinaryExpr)
+		self.expr(be.X)
+
+		// type set unions are spaced out, constant expressions (e.g.: array lengths) are not
+		if be.Op == token.OR {
+			self.write(` | `)
+		} else {
+			self.write(be.Op.String())
+		}
+
+		self.expr(be.Y)
 	default:
-		return ``
+		self.write(types.ExprString(typ))
 	}
 }
 
+// Appends the parameter and result lists of a function type, e.g.: "(a, b int) error"
+func (self *tokenizer) funcType(fn *ast.FuncType) {
+	self.write(`(`)
+	self.fieldList(fn.Params)
+	self.write(`)`)
+
+	if fn.Results != nil {
+		switch results := fn.Results.List; {
+		case len(results) == 0:
+			break
+		case len(results) == 1 && len(results[0].Names) == 0:
+			self.write(` `)
+			self.expr(results[0].Type)
+		default:
+			self.write(` (`)
+			self.fieldList(fn.Results)
+			self.write(`)`)
+		}
+	}
+}
+
+// Appends a comma-separated field list, preserving the original grouping of names.
+func (self *tokenizer) fieldList(list *ast.FieldList) {
+	if list != nil {
+		for i, field := range list.List {
+			if i > 0 {
+				self.write(`, `)
+			}
+
+			self.field(field)
+		}
+	}
+}
+
+func (self *tokenizer) field(field *ast.Field) {
+	var names []string
+
+	for _, name := range field.Names {
+		names = append(names, name.String())
+	}
+
+	if len(names) > 0 {
+		self.write(strings.Join(names, `, `) + ` `)
+	}
+
+	self.expr(field.Type)
+}
+
+// Returns the source text the given tokens make up.
+func tokensToString(tokens []Token) string {
+	var out strings.Builder
+
+	for _, token := range tokens {
+		out.WriteString(token.Text)
+	}
+
+	return out.String()
+}
+
+// Expands a type parameter list into one TypeParam per declared name.
+func astFieldListToTypeParams(list *ast.FieldList, file *File) (params []TypeParam) {
+	if list != nil {
+		for group, field := range list.List {
+			tokens := astTypeToTokens(field.Type, file, typeParamNames(list)...)
+
+			for _, name := range field.Names {
+				params = append(params, TypeParam{
+					Name:             name.String(),
+					Constraint:       tokensToString(tokens),
+					ConstraintTokens: tokens,
+					Group:            group,
+				})
+			}
+		}
+	}
+
+	return
+}
+
+// Returns the names declared in a type parameter list.
+func typeParamNames(list *ast.FieldList) (names []string) {
+	if list != nil {
+		for _, field := range list.List {
+			for _, name := range field.Names {
+				names = append(names, name.String())
+			}
+		}
+	}
+
+	return
+}
+
+// Returns the base type identifier of a method receiver, along with the names of any
+// type parameters the receiver is instantiated with.
+func astReceiverTypeIdent(expr ast.Expr) (*ast.Ident, []string) {
+	var params []string
+
+	switch expr.(type) {
+	case *ast.IndexExpr: // e.g.: func (l List[T]) Hello()
+		ie := expr.(*ast.IndexExpr)
+		expr = ie.X
+		params = append(params, astTypeToString(ie.Index))
+	case *ast.IndexListExpr: // e.g.: func (m Map[K, V]) Hello()
+		ile := expr.(*ast.IndexListExpr)
+		expr = ile.X
+
+		for _, index := range ile.Indices {
+			params = append(params, astTypeToString(index))
+		}
+	}
+
+	if ident, ok := expr.(*ast.Ident); ok {
+		return ident, params
+	}
+
+	return nil, nil
+}
+
 func formatAstComment(doc *ast.CommentGroup) string {
 	if doc != nil {
 		return strings.TrimSpace(doc.Text())
@@ -437,25 +1376,31 @@ func formatAstComment(doc *ast.CommentGroup) string {
 	return ``
 }
 
-// func astFileExamples(file *ast.File) (examples []Example) {
-// 	for _, ex := range doc.Examples(file) {
-// 		examples = append(examples, Example{
-// 			Name:    ex.Name,
-// 			Comment: ex.Doc,
-// 			Output:  ex.Output,
-// 		})
-// 	}
-
-// 	return
-// }
-
-func mustAstNodeToString(node ast.Node) string {
+// Returns the source representation of the given node, as gofmt would print it.
+func astNodeToString(node ast.Node) (string, error) {
 	var buf bytes.Buffer
 	var fset = token.NewFileSet()
 
 	if err := format.Node(&buf, fset, node); err == nil {
-		return buf.String()
+		return buf.String(), nil
 	} else {
-		panic(err.Error())
+		return ``, err
 	}
 }
+
+// Records a declaration that can't be documented as a diagnostic.  Only the declaration itself is
+// skipped; the rest of the file is documented as usual.
+func (self *File) skipDecl(node ast.Node, err error) {
+	var diag = Diagnostic{
+		Severity: SeverityError,
+		File:     self.Name,
+		Message:  fmt.Sprintf("cannot document declaration: %v", err),
+	}
+
+	if pos := self.position(node); pos != nil {
+		diag.Line = pos.Line
+		diag.Column = pos.Column
+	}
+
+	self.Package.addDiagnostics(diag)
+}
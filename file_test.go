@@ -0,0 +1,277 @@
+package main
+
+import (
+	"bytes"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/token"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+)
+
+// Each type expression should be rendered the way gofmt prints it.
+func TestAstTypeToString(t *testing.T) {
+	var cases = []struct {
+		name string
+		expr string
+	}{
+		{`ident`, `int`},
+		{`selector`, `io.Reader`},
+		{`pointer`, `*bytes.Buffer`},
+		{`slice`, `[]string`},
+		{`array`, `[4]byte`},
+		{`array-const`, `[Size]uint8`},
+		{`array-ellipsis`, `[...]int`},
+		{`map`, `map[string][]int`},
+		{`map-of-maps`, `map[string]map[int]*T`},
+		{`chan`, `chan int`},
+		{`chan-send`, `chan<- int`},
+		{`chan-recv`, `<-chan int`},
+		{`chan-of-recv-chan`, `chan (<-chan int)`},
+		{`func`, `func()`},
+		{`func-args`, `func(a int, b string) error`},
+		{`func-grouped-args`, `func(a, b int) (n int, err error)`},
+		{`func-unnamed-results`, `func(int) (int, error)`},
+		{`func-variadic`, `func(format string, args ...interface{})`},
+		{`func-returning-func`, `func() func(int) bool`},
+		{`struct-empty`, `struct{}`},
+		{`struct`, `struct{ X, Y float64 }`},
+		{`struct-embedded`, `struct{ io.Reader }`},
+		{`interface-empty`, `interface{}`},
+		{`interface`, `interface{ Read(p []byte) (int, error) }`},
+		{`interface-embedded`, `interface{ io.Reader }`},
+		{`paren`, `(int)`},
+		{`paren-pointer`, `*(T)`},
+		{`generic`, `List[int]`},
+		{`generic-multiple`, `Map[string, []int]`},
+		{`generic-selector`, `atomic.Pointer[T]`},
+		{`generic-nested`, `Tree[Pair[K, V]]`},
+		{`union`, `interface{ ~int | ~string }`},
+		{`union-types`, `interface{ int | float64 }`},
+		{`any`, `any`},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			var fset = token.NewFileSet()
+			var want bytes.Buffer
+
+			if expr, err := parser.ParseExprFrom(fset, ``, c.expr, 0); err == nil {
+				if err := format.Node(&want, fset, expr); err != nil {
+					t.Fatalf("cannot format %q: %v", c.expr, err)
+				}
+
+				if got := astTypeToString(expr); got != want.String() {
+					t.Errorf("astTypeToString(%q) = %q, want %q", c.expr, got, want.String())
+				}
+			} else {
+				t.Fatalf("cannot parse %q: %v", c.expr, err)
+			}
+		})
+	}
+}
+
+// Types are rendered on one line (as they appear in signatures), even where gofmt would spread them
+// across several.
+func TestAstTypeToStringInline(t *testing.T) {
+	var cases = []struct {
+		expr string
+		want string
+	}{
+		{`struct{ Name string; Age int }`, `struct{ Name string; Age int }`},
+		{"struct{ Name string `json:\"name\"` }", "struct{ Name string `json:\"name\"` }"},
+		{`struct {
+			Name string
+			Age  int
+		}`, `struct{ Name string; Age int }`},
+		{`interface {
+			io.Reader
+			Close() error
+		}`, `interface{ io.Reader; Close() error }`},
+		{`func(
+			a int,
+			b string,
+		) error`, `func(a int, b string) error`},
+	}
+
+	for _, c := range cases {
+		if expr, err := parser.ParseExpr(c.expr); err == nil {
+			if got := astTypeToString(expr); got != c.want {
+				t.Errorf("astTypeToString(%q) = %q, want %q", c.expr, got, c.want)
+			}
+		} else {
+			t.Fatalf("cannot parse %q: %v", c.expr, err)
+		}
+	}
+}
+
+// Writes the given files (by path, relative to the module root) to a new module named
+// "example.com/test", and returns its directory.
+func writeTestModule(t *testing.T, files map[string]string) string {
+	var dir = t.TempDir()
+
+	files[`go.mod`] = "module example.com/test\n\ngo 1.21\n"
+
+	for name, src := range files {
+		var path = filepath.Join(dir, filepath.FromSlash(name))
+
+		if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+			t.Fatal(err)
+		}
+
+		if err := os.WriteFile(path, []byte(src), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	return dir
+}
+
+// Scans a module made of the given files offline and without caching (unless the options say
+// otherwise), failing the test if it can't be scanned.
+func scanTestModule(t *testing.T, files map[string]string, options *ScanOptions) *Module {
+	if options == nil {
+		options = &ScanOptions{NoCache: true}
+	}
+
+	options.StartDir = writeTestModule(t, files)
+	options.Offline = true
+
+	if mod, err := ScanDir(options); err == nil {
+		return mod
+	} else {
+		t.Fatalf("cannot scan: %v", err)
+		return nil
+	}
+}
+
+// Type parameters in scope in a signature aren't linked, even where a type of the same name exists.
+func TestSignatureTypeParamsNotLinked(t *testing.T) {
+	var mod = scanTestModule(t, map[string]string{
+		`g.go`: `package g
+
+type T struct{}
+
+type List[T any] []T
+
+func (l List[T]) Get(i int) T { return l[i] }
+
+type Getter[T any] interface {
+	Get(i int) T
+}
+`,
+	}, nil)
+
+	var pkg = mod.Package
+
+	for _, method := range []*Method{pkg.Types[`List`].Methods[0], pkg.Types[`Getter`].InterfaceMethods[0]} {
+		for _, token := range method.SignatureTokens {
+			if token.Symbol == `T` {
+				t.Errorf("%s: type parameter T is linked to %q", method.Name, token.URL)
+			}
+		}
+	}
+}
+
+// Struct tags are split the same way reflect.StructTag.Lookup reads them.
+func TestParseStructTag(t *testing.T) {
+	var cases = []struct {
+		tag  string
+		want [][2]string
+	}{
+		{``, nil},
+		{`json:"name"`, [][2]string{{`json`, `name`}}},
+		{`json:"name,omitempty" yaml:"name"`, [][2]string{{`json`, `name,omitempty`}, {`yaml`, `name`}}},
+		{`  json:"a"   yaml:"b"  `, [][2]string{{`json`, `a`}, {`yaml`, `b`}}},
+		{`default:"a \"quoted\" value"`, [][2]string{{`default`, `a "quoted" value`}}},
+		{`json:""`, [][2]string{{`json`, ``}}},
+		{`json:"a" malformed yaml:"b"`, [][2]string{{`json`, `a`}}},
+		{`json:"unterminated`, nil},
+		{`json:unquoted`, nil},
+	}
+
+	for _, c := range cases {
+		if got := parseStructTag(c.tag); !reflect.DeepEqual(got, c.want) {
+			t.Errorf("parseStructTag(%q) = %q, want %q", c.tag, got, c.want)
+		}
+	}
+}
+
+// Each tag records the key the field is serialized as, and whether it's omitted when empty or
+// skipped altogether.
+func TestFieldParseTag(t *testing.T) {
+	var cases = []struct {
+		tag       string
+		format    string
+		key       string
+		omitEmpty bool
+		skip      bool
+	}{
+		{"`json:\"name\"`", `json`, `name`, false, false},
+		{"`json:\"name,omitempty\"`", `json`, `name`, true, false},
+		{"`json:\",omitempty\"`", `json`, `Field`, true, false},
+		{"`json:\"-\"`", `json`, ``, false, true},
+		{"`json:\"-,\"`", `json`, `-`, false, false},
+		{"`yaml:\"field,inline\" json:\"other\"`", `yaml`, `field`, false, false},
+		{"`env:\"FIELD\" default:\"1\"`", `env`, `FIELD`, false, false},
+	}
+
+	for _, c := range cases {
+		var field = &Field{
+			Name: `Field`,
+		}
+
+		field.parseTag(&ast.BasicLit{Kind: token.STRING, Value: c.tag})
+
+		if tag, ok := field.Tags[c.format]; !ok {
+			t.Errorf("%s: no %s tag", c.tag, c.format)
+		} else if tag.Key != c.key || tag.OmitEmpty != c.omitEmpty || tag.Skip != c.skip {
+			t.Errorf("%s: got key=%q omitempty=%v skip=%v, want key=%q omitempty=%v skip=%v",
+				c.tag, tag.Key, tag.OmitEmpty, tag.Skip, c.key, c.omitEmpty, c.skip)
+		}
+	}
+}
+
+// Blocks are documented with a type only if at least 75% of their values are of that type.
+func TestValueDeclTypeName(t *testing.T) {
+	var cases = []struct {
+		decl string
+		want string
+	}{
+		{`const A Color = 1`, `Color`},
+		{`const ( A Color = iota; B; C )`, `Color`},
+		{`const ( A Color = iota; B; C; D = 4 )`, `Color`},
+		{`const ( A Color = iota; B = 2; C = 3 )`, ``},
+		{`const ( A Color = iota; B Shade = 2 )`, ``},
+		{`const ( A = 1; B = 2 )`, ``},
+		{`const A time.Duration = 1`, ``},
+		{`var ( A *Color; B, C Color; D int )`, ``},
+		{`var ( A *Color; B Color; C Color; D = 1 )`, `Color`},
+		{`var ( A Color; B = Color(1) )`, `Color`},
+		{`var A List[int]`, `List`},
+	}
+
+	for _, c := range cases {
+		if file, err := parser.ParseFile(token.NewFileSet(), ``, `package p; `+c.decl, 0); err == nil {
+			if got := valueDeclTypeName(file.Decls[0].(*ast.GenDecl)); got != c.want {
+				t.Errorf("valueDeclTypeName(%q) = %q, want %q", c.decl, got, c.want)
+			}
+		} else {
+			t.Fatalf("cannot parse %q: %v", c.decl, err)
+		}
+	}
+}
+
+// Nodes that can't be formatted are reported as errors rather than panicking.
+func TestAstNodeToString(t *testing.T) {
+	if got, err := astNodeToString(&ast.BinaryExpr{X: ast.NewIdent(`a`), Op: token.ADD, Y: ast.NewIdent(`b`)}); err != nil || got != `a + b` {
+		t.Errorf("astNodeToString(a + b) = %q, %v", got, err)
+	}
+
+	if got, err := astNodeToString(&ast.Field{Type: ast.NewIdent(`int`)}); err == nil {
+		t.Errorf("astNodeToString(field) = %q, want an error", got)
+	}
+}
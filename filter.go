@@ -0,0 +1,119 @@
+package main
+
+import (
+	"path"
+	"path/filepath"
+	"strings"
+
+	"github.com/ghetzel/go-stockutil/fileutil"
+	"github.com/ghetzel/go-stockutil/log"
+)
+
+// The name of the file (in the directory being scanned) listing additional exclude patterns, one per line.
+var IgnoreFileName = `.owndocignore`
+
+// Directories the go command never treats as containing packages of the module being built.
+var DefaultExcludedDirs = []string{
+	`vendor`,
+	`testdata`,
+	`node_modules`,
+}
+
+// Decides which subdirectories a recursive scan descends into.  Include and exclude patterns use
+// path.Match syntax and are matched against a directory's path relative to the scan root (e.g.:
+// "cmd/*").  Exclude patterns without any slashes are matched against the directory's name instead.
+type dirFilter struct {
+	root    string
+	include []string
+	exclude []string
+}
+
+func newDirFilter(root string, options *ScanOptions) *dirFilter {
+	var filter = &dirFilter{
+		root:    root,
+		include: options.Include,
+		exclude: options.Exclude,
+	}
+
+	if lines, err := fileutil.ReadAllLines(filepath.Join(root, IgnoreFileName)); err == nil {
+		for _, line := range lines {
+			if line = strings.TrimSpace(line); line != `` && !strings.HasPrefix(line, `#`) {
+				filter.exclude = append(filter.exclude, strings.Trim(line, `/`))
+			}
+		}
+	}
+
+	return filter
+}
+
+// Returns whether the given directory should be scanned for packages.
+func (self *dirFilter) allows(dir string) bool {
+	var name = filepath.Base(dir)
+
+	// the same rules the go command uses for "./..."
+	if strings.HasPrefix(name, `.`) || strings.HasPrefix(name, `_`) {
+		return false
+	}
+
+	for _, excluded := range DefaultExcludedDirs {
+		if name == excluded {
+			return false
+		}
+	}
+
+	// nested modules are documented separately
+	if fileutil.IsNonemptyFile(filepath.Join(dir, `go.mod`)) {
+		log.Infof("skipping nested module in %s", dir)
+		return false
+	}
+
+	var rel = name
+
+	if r, err := filepath.Rel(self.root, dir); err == nil {
+		rel = filepath.ToSlash(r)
+	}
+
+	for _, pattern := range self.exclude {
+		var excluded bool
+
+		// like .gitignore, a bare name excludes directories of that name at any depth
+		if strings.Contains(pattern, `/`) {
+			excluded = matchDirPattern(pattern, rel, false)
+		} else {
+			excluded, _ = path.Match(pattern, name)
+		}
+
+		if excluded {
+			log.Infof("skipping excluded directory %s", dir)
+			return false
+		}
+	}
+
+	if len(self.include) > 0 {
+		for _, pattern := range self.include {
+			if matchDirPattern(pattern, rel, true) {
+				return true
+			}
+		}
+
+		return false
+	}
+
+	return true
+}
+
+// Matches a directory pattern against a relative path, component by component.  A pattern matches
+// the directory it names and everything beneath it.  If partial is set, the directories above a
+// possible match (which must be traversed to reach it) also match.
+func matchDirPattern(pattern string, rel string, partial bool) bool {
+	var patterns = strings.Split(pattern, `/`)
+	var parts = strings.Split(rel, `/`)
+
+	for i := 0; i < len(patterns) && i < len(parts); i++ {
+		if ok, _ := path.Match(patterns[i], parts[i]); !ok {
+			return false
+		}
+	}
+
+	return len(parts) >= len(patterns) || partial
+}
@@ -0,0 +1,62 @@
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestDirFilter(t *testing.T) {
+	var root = t.TempDir()
+
+	for _, dir := range []string{`nested`, `a/b`} {
+		if err := os.MkdirAll(filepath.Join(root, dir), 0755); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if err := os.WriteFile(filepath.Join(root, `nested`, `go.mod`), []byte("module example.com/nested\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := os.WriteFile(filepath.Join(root, IgnoreFileName), []byte("# generated code\ngen/\n\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	var cases = []struct {
+		include []string
+		exclude []string
+		dir     string
+		want    bool
+	}{
+		{dir: `pkg`, want: true},
+		{dir: `a/b`, want: true},
+		{dir: `.git`, want: false},
+		{dir: `_old`, want: false},
+		{dir: `vendor`, want: false},
+		{dir: `a/testdata`, want: false},
+		{dir: `node_modules`, want: false},
+		{dir: `nested`, want: false},
+		{dir: `gen`, want: false},
+		{dir: `a/gen`, want: false},
+		{exclude: []string{`mock*`}, dir: `a/mocks`, want: false},
+		{exclude: []string{`a/b`}, dir: `a/b/c`, want: false},
+		{exclude: []string{`a/b`}, dir: `x/a/b`, want: true},
+		{include: []string{`cmd/*`}, dir: `cmd`, want: true},
+		{include: []string{`cmd/*`}, dir: `cmd/tool`, want: true},
+		{include: []string{`cmd/*`}, dir: `cmd/tool/internal`, want: true},
+		{include: []string{`cmd/*`}, dir: `pkg`, want: false},
+		{include: []string{`cmd/*`}, exclude: []string{`internal`}, dir: `cmd/tool/internal`, want: false},
+	}
+
+	for _, c := range cases {
+		var filter = newDirFilter(root, &ScanOptions{
+			Include: c.include,
+			Exclude: c.exclude,
+		})
+
+		if got := filter.allows(filepath.Join(root, filepath.FromSlash(c.dir))); got != c.want {
+			t.Errorf("include=%q exclude=%q: allows(%q) = %v, want %v", c.include, c.exclude, c.dir, got, c.want)
+		}
+	}
+}
@@ -1,6 +1,6 @@
 module github.com/ghetzel/godocgen
 
-go 1.13
+go 1.25.0
 
 require (
 	github.com/PuerkitoBio/goquery v1.5.1
@@ -10,6 +10,13 @@ require (
 	github.com/labstack/gommon v0.3.0
 	github.com/mcuadros/go-defaults v1.1.0
 	github.com/montanaflynn/stats v0.5.0
-	golang.org/x/tools v0.0.0-20200207183749-b753a1ba74fa
+	golang.org/x/tools v0.47.0
+	golang.org/x/tools/go/vcs v0.1.0-deprecated
 	golang.org/x/xerrors v0.0.0-20191204190536-9bdfabe68543 // indirect
 )
+
+require (
+	golang.org/x/mod v0.37.0 // indirect
+	golang.org/x/sync v0.21.0 // indirect
+	golang.org/x/sys v0.46.0 // indirect
+)
@@ -0,0 +1,180 @@
+package main
+
+import (
+	"go/types"
+	"sort"
+	"strings"
+)
+
+// Refers to a type declared in the module.
+type TypeReference struct {
+	// The name of the package declaring the type.
+	Package string
+
+	// The import path of the package, as it appears in the URL of its page.
+	ImportPath string
+
+	// The name of the type.
+	Name string
+
+	// Whether only pointers to the type (rather than values of it) implement the interface.
+	Pointer bool `json:",omitempty"`
+}
+
+// An interface declared in the module, along with the types in the module that implement it.
+type InterfaceImplementations struct {
+	Interface     TypeReference
+	ImplementedBy []TypeReference `json:",omitempty"`
+}
+
+// A named type declared in the module, along with the method sets of its values and pointers,
+// keyed by method ID.
+type methodSets struct {
+	ref      TypeReference
+	typ      *Type
+	values   map[string]string
+	pointers map[string]string
+}
+
+// Determines which types in the module implement which of its interfaces, by comparing method sets
+// (including promoted methods).  This requires type information, so nothing is found unless packages
+// were loaded with it.  Generic types and interfaces, and interfaces that are empty or only usable
+// as constraints, are left out.
+func (self *Module) resolveImplementations() {
+	var interfaces []*methodSets
+	var concrete []*methodSets
+
+	self.Walk(func(pkg *Package) error {
+		if pkg.typesPkg == nil {
+			return nil
+		}
+
+		for _, typ := range pkg.Types {
+			typ.Implements = nil
+			typ.ImplementedBy = nil
+
+			var named *types.Named
+
+			if obj, ok := pkg.typesPkg.Scope().Lookup(typ.Name).(*types.TypeName); ok {
+				if named, ok = obj.Type().(*types.Named); !ok || named.TypeParams().Len() > 0 {
+					continue
+				}
+			} else {
+				continue
+			}
+
+			var sets = &methodSets{
+				ref: TypeReference{
+					Package:    pkg.Name,
+					ImportPath: pkg.ImportPath,
+					Name:       typ.Name,
+				},
+				typ:    typ,
+				values: methodSetSignatures(types.NewMethodSet(named)),
+			}
+
+			if iface, ok := named.Underlying().(*types.Interface); ok {
+				if iface.IsMethodSet() && iface.NumMethods() > 0 {
+					interfaces = append(interfaces, sets)
+				}
+			} else {
+				sets.pointers = methodSetSignatures(types.NewMethodSet(types.NewPointer(named)))
+				concrete = append(concrete, sets)
+			}
+		}
+
+		return nil
+	})
+
+	sortMethodSets(interfaces)
+	sortMethodSets(concrete)
+
+	self.Interfaces = nil
+
+	for _, iface := range interfaces {
+		var impls = InterfaceImplementations{
+			Interface: iface.ref,
+		}
+
+		for _, impl := range concrete {
+			var ref = impl.ref
+			var target = iface.ref
+
+			if implementsMethods(impl.values, iface.values) {
+				ref.Pointer = false
+			} else if implementsMethods(impl.pointers, iface.values) {
+				ref.Pointer = true
+			} else {
+				continue
+			}
+
+			target.Pointer = ref.Pointer
+			impls.ImplementedBy = append(impls.ImplementedBy, ref)
+			iface.typ.ImplementedBy = append(iface.typ.ImplementedBy, ref)
+			impl.typ.Implements = append(impl.typ.Implements, target)
+		}
+
+		self.Interfaces = append(self.Interfaces, impls)
+	}
+}
+
+// Returns the signatures of the methods in the given method set, by method ID.  Signatures are
+// rendered with fully-qualified type names, so that they can be compared between packages that
+// were type-checked separately.
+func methodSetSignatures(mset *types.MethodSet) map[string]string {
+	var signatures = make(map[string]string)
+
+	for i := 0; i < mset.Len(); i++ {
+		if fn, ok := mset.At(i).Obj().(*types.Func); ok {
+			signatures[fn.Id()] = signatureKey(fn.Type().(*types.Signature))
+		}
+	}
+
+	return signatures
+}
+
+// Returns the parameter and result types of the given signature, without their names.
+func signatureKey(sig *types.Signature) string {
+	var qualifier = func(pkg *types.Package) string {
+		return pkg.Path()
+	}
+
+	var list = func(tuple *types.Tuple, variadic bool) string {
+		var parts []string
+
+		for i := 0; i < tuple.Len(); i++ {
+			var typ = types.TypeString(tuple.At(i).Type(), qualifier)
+
+			if variadic && i == tuple.Len()-1 {
+				typ = `...` + typ
+			}
+
+			parts = append(parts, typ)
+		}
+
+		return strings.Join(parts, `, `)
+	}
+
+	return `(` + list(sig.Params(), sig.Variadic()) + `) (` + list(sig.Results(), false) + `)`
+}
+
+// Returns whether a method set includes every one of the given methods.
+func implementsMethods(methods map[string]string, required map[string]string) bool {
+	for id, signature := range required {
+		if methods[id] != signature {
+			return false
+		}
+	}
+
+	return true
+}
+
+func sortMethodSets(sets []*methodSets) {
+	sort.Slice(sets, func(i int, j int) bool {
+		if sets[i].ref.ImportPath != sets[j].ref.ImportPath {
+			return sets[i].ref.ImportPath < sets[j].ref.ImportPath
+		}
+
+		return sets[i].ref.Name < sets[j].ref.Name
+	})
+}
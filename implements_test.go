@@ -0,0 +1,77 @@
+package main
+
+import (
+	"reflect"
+	"testing"
+)
+
+// Implementations are found across packages, counting promoted methods, and note whether only
+// pointers implement the interface.
+func TestResolveImplementations(t *testing.T) {
+	var mod = scanTestModule(t, map[string]string{
+		`shape.go`: `package shape
+
+type Shape interface {
+	Area() float64
+}
+
+type Empty interface{}
+
+type Number interface {
+	~int | ~float64
+}
+
+type Square struct{ Side float64 }
+
+func (s Square) Area() float64 { return s.Side * s.Side }
+
+type Circle struct{ Radius float64 }
+
+func (c *Circle) Area() float64 { return 3 * c.Radius * c.Radius }
+
+type Framed struct{ *Circle }
+
+type Wrapped struct{ Circle }
+
+type Box[T any] struct{ Area T }
+
+func (b Box[T]) Volume() T { return b.Area }
+
+type Blob struct{}
+
+func (b Blob) Area() int { return 0 }
+`,
+		`poly/poly.go`: `package poly
+
+type Triangle struct{ Base, Height float64 }
+
+func (t Triangle) Area() float64 { return t.Base * t.Height / 2 }
+`,
+	}, &ScanOptions{NoCache: true, TypeCheck: true})
+
+	if len(mod.Interfaces) != 1 {
+		t.Fatalf("interfaces = %+v, want Shape only", mod.Interfaces)
+	}
+
+	var got []string
+
+	for _, ref := range mod.Interfaces[0].ImplementedBy {
+		if ref.Pointer {
+			got = append(got, `*`+ref.Package+`.`+ref.Name)
+		} else {
+			got = append(got, ref.Package+`.`+ref.Name)
+		}
+	}
+
+	if want := []string{`*shape.Circle`, `shape.Framed`, `shape.Square`, `*shape.Wrapped`, `poly.Triangle`}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Shape implemented by %q, want %q", got, want)
+	}
+
+	if implements := mod.Package.Types[`Circle`].Implements; len(implements) != 1 || implements[0].Name != `Shape` || !implements[0].Pointer {
+		t.Errorf("Circle implements %+v, want *Circle to implement Shape", implements)
+	}
+
+	if implements := mod.Package.Types[`Blob`].Implements; len(implements) != 0 {
+		t.Errorf("Blob implements %+v, want nothing", implements)
+	}
+}
@@ -5,6 +5,7 @@ package main
 import (
 	"encoding/json"
 	"os"
+	"strings"
 
 	"github.com/ghetzel/cli"
 	"github.com/ghetzel/go-stockutil/log"
@@ -37,14 +38,13 @@ func main() {
 		{
 			Name:  `generate`,
 			Usage: `Generate a JSON manifest decribing the current package and all subpackages.`,
-			Flags: []cli.Flag{},
+			Flags: scanFlags,
 			Action: func(c *cli.Context) {
-				if mod, err := ScanDir(&ScanOptions{
-					StartDir: c.Args().First(),
-				}); err == nil {
+				if mod, err := ScanDir(scanOptionsFromContext(c)); err == nil {
 					enc := json.NewEncoder(os.Stdout)
 					enc.SetIndent(``, `    `)
 					enc.Encode(mod)
+					checkExamples(c, mod)
 				} else {
 					log.Fatal(err)
 				}
@@ -52,7 +52,7 @@ func main() {
 		}, {
 			Name:  `render`,
 			Usage: `Render a module's documentation as a standalone static site.`,
-			Flags: []cli.Flag{
+			Flags: append([]cli.Flag{
 				cli.StringFlag{
 					Name:   `output-dir, o`,
 					Usage:  `The output directory where generated files will be placed.`,
@@ -63,11 +63,9 @@ func main() {
 					Name:  `property, p`,
 					Usage: `A key=value pair to expose to all page generation templates.`,
 				},
-			},
+			}, scanFlags...),
 			Action: func(c *cli.Context) {
-				if mod, err := ScanDir(&ScanOptions{
-					StartDir: c.Args().First(),
-				}); err == nil {
+				if mod, err := ScanDir(scanOptionsFromContext(c)); err == nil {
 					var props = maputil.M(nil)
 
 					for _, pair := range c.StringSlice(`property`) {
@@ -81,6 +79,8 @@ func main() {
 							Properties: props.MapNative(),
 						}),
 					)
+
+					checkExamples(c, mod)
 				} else {
 					log.Fatal(err)
 				}
@@ -90,3 +90,100 @@ func main() {
 
 	app.Run(os.Args)
 }
+
+// Flags shared by all commands that scan a module.
+var scanFlags = []cli.Flag{
+	cli.BoolFlag{
+		Name:  `typecheck, T`,
+		Usage: `Load packages with full type information (dependencies must be available in the module cache).`,
+	},
+	cli.BoolFlag{
+		Name:   `offline, O`,
+		Usage:  `Guarantee that scanning makes no network requests; repository URLs are derived from local sources only.`,
+		EnvVar: `OWNDOC_OFFLINE`,
+	},
+	cli.StringFlag{
+		Name:   `repository-url, R`,
+		Usage:  `The URL of the repository containing the module (default: read from .git/config or derived from the module path).`,
+		EnvVar: `OWNDOC_REPOSITORY_URL`,
+	},
+	cli.BoolFlag{
+		Name:  `resolve-repository`,
+		Usage: `Without a --repository-url or Git remote, look the repository up over the network (as "go get" would) rather than deriving it from the module path; ignored with --offline.`,
+	},
+	cli.StringSliceFlag{
+		Name:  `platform, P`,
+		Usage: `A GOOS/GOARCH[,tag,...] combination to evaluate build constraints for; may be given multiple times, except with --typecheck (default: the current platform).`,
+	},
+	cli.StringSliceFlag{
+		Name:  `include, i`,
+		Usage: `Only scan subdirectories matching this pattern (e.g.: "pkg/*"); may be given multiple times.`,
+	},
+	cli.StringSliceFlag{
+		Name:  `exclude, x`,
+		Usage: `Skip subdirectories matching this pattern (in addition to any listed in .owndocignore); may be given multiple times.`,
+	},
+	cli.IntFlag{
+		Name:  `jobs, j`,
+		Usage: `The maximum number of packages to load concurrently (default: the number of CPUs).`,
+	},
+	cli.StringFlag{
+		Name:   `cache-dir`,
+		Usage:  `Where to cache the results of scanning packages (default: $XDG_CACHE_HOME/owndoc).`,
+		EnvVar: `OWNDOC_CACHE_DIR`,
+	},
+	cli.BoolFlag{
+		Name:  `no-cache`,
+		Usage: `Scan every package from scratch, without reading or writing the cache.`,
+	},
+	cli.BoolFlag{
+		Name:  `unexported, u`,
+		Usage: `Also document unexported types, functions, methods and fields (e.g.: for internal developer documentation).`,
+	},
+	cli.BoolFlag{
+		Name:  `tolerant`,
+		Usage: `Skip files and packages that cannot be read or parsed (reporting them as diagnostics) instead of failing.`,
+	},
+	cli.BoolFlag{
+		Name:  `run-examples`,
+		Usage: `Run each package's examples with go test, and record whether they produce their expected output.`,
+	},
+	cli.BoolFlag{
+		Name:  `fail-on-examples`,
+		Usage: `Run each package's examples (as with --run-examples), and exit with an error if any of them fail.`,
+	},
+	cli.StringFlag{
+		Name:   `external-url`,
+		Usage:  `A template for the URL of the documentation of packages outside of the module, given their {{ .ImportPath }} and the {{ .Symbol }} (if any) being referred to (default: pkg.go.dev).`,
+		EnvVar: `OWNDOC_EXTERNAL_URL`,
+	},
+}
+
+func scanOptionsFromContext(c *cli.Context) *ScanOptions {
+	return &ScanOptions{
+		StartDir:          c.Args().First(),
+		TypeCheck:         c.Bool(`typecheck`),
+		Offline:           c.Bool(`offline`),
+		RepositoryURL:     c.String(`repository-url`),
+		ResolveRepository: c.Bool(`resolve-repository`),
+		Platforms:         c.StringSlice(`platform`),
+		Include:           c.StringSlice(`include`),
+		Exclude:           c.StringSlice(`exclude`),
+		Jobs:              c.Int(`jobs`),
+		CacheDir:          c.String(`cache-dir`),
+		NoCache:           c.Bool(`no-cache`),
+		Tolerant:          c.Bool(`tolerant`),
+		Unexported:        c.Bool(`unexported`),
+		RunExamples:       c.Bool(`run-examples`) || c.Bool(`fail-on-examples`),
+		ExternalURL:       c.String(`external-url`),
+	}
+}
+
+// Exits with an error if any examples failed and --fail-on-examples was given.
+func checkExamples(c *cli.Context, mod *Module) {
+	if c.Bool(`fail-on-examples`) {
+		if failed := mod.failedExamples(); len(failed) > 0 {
+			log.Fatalf("%d example(s) failed: %s", len(failed), strings.Join(failed, `, `))
+		}
+	}
+}
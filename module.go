@@ -1,12 +1,13 @@
 package main
 
 import (
-	"encoding/json"
 	"errors"
-	"os"
+	"go/types"
 	"path/filepath"
 	"sort"
+	"strings"
 
+	"github.com/ghetzel/go-stockutil/stringutil"
 	"github.com/mcuadros/go-defaults"
 )
 
@@ -17,9 +18,27 @@ type ModuleWalkFunc func(pkg *Package) error
 var stop error = errors.New(`quit`)
 
 type ScanOptions struct {
-	Version          string
-	StartDir         string `default:"."`
-	VersionConstName string `default:"Version"`
+	Version           string
+	StartDir          string `default:"."`
+	VersionConstName  string `default:"Version"`
+	TypeCheck         bool
+	Offline           bool
+	RepositoryURL     string
+	ResolveRepository bool
+	Platforms         []string
+	Include           []string
+	Exclude           []string
+	Jobs              int
+	CacheDir          string
+	NoCache           bool
+	Tolerant          bool
+	Unexported        bool
+	RunExamples       bool
+	ExternalURL       string `default:"https://pkg.go.dev/{{ .ImportPath }}{{ with .Symbol }}#{{ . }}{{ end }}"`
+	filter            *dirFilter
+	jobs              chan struct{}
+	cache             *scanCache
+	repositories      *repositoryCache
 }
 
 type Metadata struct {
@@ -33,6 +52,9 @@ type Module struct {
 	Metadata    Metadata
 	PackageList []PackageSummary
 	Package     *Package
+	Diagnostics []Diagnostic               `json:",omitempty"`
+	Interfaces  []InterfaceImplementations `json:",omitempty"`
+	packages    map[string]*Package
 }
 
 func (self *Module) Walk(fn ModuleWalkFunc) error {
@@ -73,10 +95,11 @@ func ScanDir(options *ScanOptions) (*Module, error) {
 
 	defaults.SetDefaults(options)
 
-	if pkg, err := LoadPackage(options.StartDir); err == nil {
-		enc := json.NewEncoder(os.Stdout)
-		enc.SetIndent(``, `    `)
+	if _, err := scanPlatforms(options); err != nil {
+		return nil, err
+	}
 
+	if pkg, err := LoadPackage(options.StartDir, options); err == nil {
 		var mod = &Module{
 			Metadata: Metadata{
 				Title:            filepath.Base(pkg.URL),
@@ -86,6 +109,8 @@ func ScanDir(options *ScanOptions) (*Module, error) {
 			Package: pkg,
 		}
 
+		mod.indexPackages()
+
 		if options.Version == `` {
 			for _, c := range pkg.Constants {
 				if c.Name == options.VersionConstName {
@@ -97,15 +122,37 @@ func ScanDir(options *ScanOptions) (*Module, error) {
 			mod.Metadata.Version = options.Version
 		}
 
+		// promoted methods are linked along with the types they're promoted to
+		mod.resolvePromotedMethods()
+
+		if err := mod.renderComments(options); err != nil {
+			return nil, err
+		}
+
+		mod.resolveImplementations()
+
+		if options.RunExamples {
+			mod.runExamples(options)
+		}
+
 		if err := mod.Walk(func(pkg *Package) error {
 			mod.PackageList = append(mod.PackageList, pkg.PackageSummary)
 
+			for _, diag := range pkg.Diagnostics {
+				if diag.Package == `` {
+					diag.Package = pkg.ImportPath
+				}
+
+				mod.Diagnostics = append(mod.Diagnostics, diag)
+			}
+
 			sort.Slice(mod.PackageList, func(i int, j int) bool {
 				return mod.PackageList[i].ImportPath < mod.PackageList[j].ImportPath
 			})
 
 			return nil
 		}); err == nil {
+			sortDiagnostics(mod.Diagnostics)
 			return mod, nil
 		} else {
 			return nil, err
@@ -114,3 +161,216 @@ func ScanDir(options *ScanOptions) (*Module, error) {
 		return nil, err
 	}
 }
+
+// Retrieve the package in this module with the given import path.
+func (self *Module) PackageByImportPath(importPath string) *Package {
+	return self.packages[importPath]
+}
+
+// Indexes the packages in this module by their import path, so that they can be looked up
+// without walking the whole tree each time.
+func (self *Module) indexPackages() {
+	self.packages = make(map[string]*Package)
+
+	self.Walk(func(pkg *Package) error {
+		self.packages[pkg.CanonicalImportPath] = pkg
+		return nil
+	})
+}
+
+// Locate the declaration of the given type expression as it appears in the given file.  Types
+// qualified with a package name are looked up in the corresponding package of this module.
+func (self *Module) lookupType(file *File, typestr string, resolved types.Type) *Type {
+	if named := namedType(resolved); named != nil && named.Obj().Pkg() != nil {
+		if pkg := self.PackageByImportPath(named.Obj().Pkg().Path()); pkg != nil {
+			return pkg.Types[named.Obj().Name()]
+		}
+
+		return nil
+	}
+
+	if file == nil || file.Package == nil {
+		return nil
+	}
+
+	typestr = strings.TrimPrefix(typestr, `*`)
+
+	if i := strings.Index(typestr, `[`); i > 0 {
+		typestr = typestr[:i]
+	}
+
+	if alias, name := stringutil.SplitPair(typestr, `.`); name != `` {
+		for _, imp := range file.Imports {
+			if imp.Alias == alias {
+				if pkg := self.PackageByImportPath(imp.PackageName); pkg != nil {
+					return pkg.Types[name]
+				}
+
+				break
+			}
+		}
+
+		return nil
+	} else {
+		return file.Package.Types[typestr]
+	}
+}
+
+// Populates the list of methods each struct type inherits from its embedded fields.
+func (self *Module) resolvePromotedMethods() {
+	self.Walk(func(pkg *Package) error {
+		for _, typ := range pkg.Types {
+			if typ.MetaType == `struct` {
+				typ.PromotedMethods = self.promotedMethods(typ)
+			}
+		}
+
+		return nil
+	})
+}
+
+type embedding struct {
+	typ  *Type
+	path string
+
+	// whether a pointer is embedded anywhere along the path
+	pointer bool
+}
+
+// Walks the embedded fields of a struct breadth-first, following the Go selector rules: methods
+// at a shallower depth shadow deeper ones, and names promoted more than once at the same depth
+// (even via the same type, embedded along two paths) are ambiguous and not promoted at all.
+//
+// Promoted methods with a pointer receiver are only in the method set of a pointer to the struct,
+// unless a pointer is embedded along the way (e.g.: "struct{ *bytes.Buffer }").
+func (self *Module) promotedMethods(typ *Type) []*Method {
+	var promoted = make([]*Method, 0)
+	var shadowed = make(map[string]bool)
+	var visited = map[*Type]bool{typ: true}
+	var current = []embedding{{typ: typ}}
+
+	for _, m := range typ.Methods {
+		if !m.IsPackageLevel {
+			shadowed[m.Name] = true
+		}
+	}
+
+	for _, f := range typ.Fields {
+		shadowed[f.Name] = true
+	}
+
+	for len(current) > 0 {
+		var next []embedding
+		var candidates = make(map[string][]*Method)
+		var fields = make(map[string]int)
+		var reached = make(map[*Type]bool)
+
+		for _, outer := range current {
+			var embedded []*Field
+
+			if outer.typ.MetaType == `interface` {
+				for _, typestr := range outer.typ.EmbeddedInterfaces {
+					embedded = append(embedded, &Field{
+						Type: typestr,
+					})
+				}
+			} else {
+				for _, field := range outer.typ.Fields {
+					if field.Embedded {
+						embedded = append(embedded, field)
+					}
+				}
+			}
+
+			for _, field := range embedded {
+				var inner = self.lookupType(outer.typ.File, field.Type, field.resolved)
+
+				// types embedded at a shallower depth have been walked already (and may embed this one)
+				if inner == nil || visited[inner] {
+					continue
+				}
+
+				reached[inner] = true
+				var path = inner.Name
+
+				if outer.path != `` {
+					path = outer.path + `.` + inner.Name
+				}
+
+				var pointer = outer.pointer || strings.HasPrefix(field.Type, `*`)
+				var methods = inner.InterfaceMethods
+
+				if inner.MetaType != `interface` {
+					methods = inner.Methods
+				}
+
+				for _, m := range methods {
+					if !m.IsPackageLevel {
+						var method = m.promotedCopy(path)
+
+						method.PointerReceiver = m.PointerReceiver && !pointer
+						candidates[m.Name] = append(candidates[m.Name], method)
+					}
+				}
+
+				for _, f := range inner.Fields {
+					fields[f.Name] += 1
+				}
+
+				next = append(next, embedding{
+					typ:     inner,
+					path:    path,
+					pointer: pointer,
+				})
+			}
+		}
+
+		for name, methods := range candidates {
+			if !shadowed[name] && len(methods) == 1 && fields[name] == 0 {
+				promoted = append(promoted, methods[0])
+			}
+
+			shadowed[name] = true
+		}
+
+		for name := range fields {
+			shadowed[name] = true
+		}
+
+		for inner := range reached {
+			visited[inner] = true
+		}
+
+		current = next
+	}
+
+	sort.Slice(promoted, func(i int, j int) bool {
+		return promoted[i].Name < promoted[j].Name
+	})
+
+	return promoted
+}
+
+// Returns a copy of a method as it is promoted through the given path of embedded fields.  The copy
+// has tokens of its own, since they're linked relative to the page of the type it's promoted to.
+func (self *Method) promotedCopy(path string) *Method {
+	var promoted = *self
+
+	promoted.PromotedFrom = path
+	promoted.SignatureTokens = append([]Token(nil), self.SignatureTokens...)
+	promoted.TypeParams = append([]TypeParam(nil), self.TypeParams...)
+	promoted.Arguments = append([]Arg(nil), self.Arguments...)
+	promoted.Returns = append([]Arg(nil), self.Returns...)
+
+	for i := range promoted.TypeParams {
+		promoted.TypeParams[i].ConstraintTokens = append([]Token(nil), self.TypeParams[i].ConstraintTokens...)
+	}
+
+	for _, args := range [][]Arg{promoted.Arguments, promoted.Returns} {
+		for i := range args {
+			args[i].TypeTokens = append([]Token(nil), args[i].TypeTokens...)
+		}
+	}
+
+	return &promoted
+}
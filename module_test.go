@@ -0,0 +1,94 @@
+package main
+
+import (
+	"strings"
+	"testing"
+)
+
+// Promoted methods follow the Go selector and method set rules.
+func TestPromotedMethods(t *testing.T) {
+	var mod = scanTestModule(t, map[string]string{
+		`p.go`: `package p
+
+type Base struct{ ID int }
+
+func (b Base) Value() int  { return b.ID }
+func (b *Base) Pointer()   {}
+func (b Base) Shadowed()   {}
+func (b Base) Ambiguous()  {}
+
+type Other struct{ Label string }
+
+func (o Other) Ambiguous() {}
+func (o Other) Unique()    {}
+func (o Other) ID()        {}
+
+type Deep struct{ Base }
+
+type Twice struct{ Deep }
+
+type Value struct {
+	Base
+	Other
+}
+
+func (v Value) Shadowed() {}
+
+type Pointer struct{ *Base }
+
+type Left struct{ Base }
+
+type Right struct{ Base }
+
+type Diamond struct {
+	Left
+	Right
+}
+
+type Nested struct{ *Deep }
+
+type Reader interface{ Read() error }
+
+type Closer interface{ Close() error }
+
+type ReadCloser interface {
+	Reader
+	Closer
+}
+
+type File struct{ ReadCloser }
+`,
+	}, nil)
+
+	var cases = []struct {
+		typ  string
+		want string
+	}{
+		// Base.ID (a field) and Other.ID (a method) are ambiguous; the pointer method is only on *Value
+		{`Value`, `Pointer(*) Unique Value`},
+		{`Pointer`, `Ambiguous Pointer Shadowed Value`},
+		{`Twice`, `Ambiguous Pointer(*) Shadowed Value`},
+		// Base is embedded twice at the same depth, so its methods are ambiguous
+		{`Diamond`, ``},
+		{`Nested`, `Ambiguous Pointer Shadowed Value`},
+		{`File`, `Close Read`},
+	}
+
+	for _, c := range cases {
+		t.Run(c.typ, func(t *testing.T) {
+			var got []string
+
+			for _, method := range mod.Package.Types[c.typ].PromotedMethods {
+				if method.PointerReceiver {
+					got = append(got, method.Name+`(*)`)
+				} else {
+					got = append(got, method.Name)
+				}
+			}
+
+			if strings.Join(got, ` `) != c.want {
+				t.Errorf("promoted methods of %s = %q, want %q", c.typ, strings.Join(got, ` `), c.want)
+			}
+		})
+	}
+}
@@ -3,23 +3,28 @@ package main
 import (
 	"fmt"
 	"go/ast"
+	"go/build"
+	"go/constant"
 	"go/doc"
+	"go/importer"
 	"go/parser"
 	"go/token"
+	"go/types"
 	"io/ioutil"
 	"os"
 	"path/filepath"
+	"runtime"
 	"sort"
+	"strconv"
 	"strings"
+	"sync"
 
-	fileutil "github.com/ghetzel/go-stockutil/fileutil"
 	"github.com/ghetzel/go-stockutil/log"
 	"github.com/ghetzel/go-stockutil/mathutil"
-	"github.com/ghetzel/go-stockutil/rxutil"
 	"github.com/ghetzel/go-stockutil/sliceutil"
 	"github.com/ghetzel/go-stockutil/stringutil"
 	"github.com/montanaflynn/stats"
-	"golang.org/x/tools/go/vcs"
+	"golang.org/x/tools/go/packages"
 )
 
 // These numbers represent a hypothetical "ideal" word count for various kinds
@@ -67,59 +72,87 @@ type PackageSummary struct {
 	ConstantCount       int
 	VariableCount       int
 	Statistics          Rollup
+
+	// Whether this is a command (i.e.: "package main") rather than a library.
+	Command bool `json:",omitempty"`
+
+	// Whether this package is internal, and may only be imported by packages rooted at ImportableFrom.
+	Internal       bool   `json:",omitempty"`
+	ImportableFrom string `json:",omitempty"`
 }
 
 type Package struct {
 	PackageSummary
-	Files     []*File
-	Constants []Value          `json:",omitempty"`
-	Variables []Value          `json:",omitempty"`
-	Functions []*Method        `json:",omitempty"`
-	Examples  []*Method        `json:",omitempty"`
-	Tests     []*Method        `json:",omitempty"`
-	Types     map[string]*Type `json:",omitempty"`
-	Packages  []*Package       `json:",omitempty"`
-	ast       *ast.Package
-}
+	Files          []*File
+	Constants      []Value          `json:",omitempty"`
+	Variables      []Value          `json:",omitempty"`
+	ConstantGroups []*ValueGroup    `json:",omitempty"`
+	VariableGroups []*ValueGroup    `json:",omitempty"`
+	Functions      []*Method        `json:",omitempty"`
+	Examples       []*Method        `json:",omitempty"`
+	Tests          []*Method        `json:",omitempty"`
+	Types          map[string]*Type `json:",omitempty"`
+	Packages       []*Package       `json:",omitempty"`
+	TestPackage    *Package         `json:",omitempty"`
+	IgnoredFiles   []string         `json:",omitempty"`
+	Diagnostics    []Diagnostic     `json:",omitempty"`
 
-func (self *Package) addFile(fname string, astfile *ast.File) error {
-	// // append examples in this file
-	// self.Examples = append(self.Examples, astFileExamples(astfile)...)
+	// Whether unexported declarations are documented as well.
+	IncludesUnexported bool `json:",omitempty"`
 
-	if stat, err := os.Stat(fname); err == nil {
-		file := &File{
-			Name:    filepath.Base(fname),
-			Package: self,
-			Size:    stat.Size(),
-			ast:     astfile,
-		}
-
-		for _, line := range fileutil.MustReadAllLines(fname) {
-			file.LineCount += 1
+	// The package documentation, rendered as HTML.
+	SynopsisHTML string `json:",omitempty"`
 
-			if rxutil.IsMatchString(`^\s*(?:\/\/.*)?$`, line) {
-				continue
-			}
+	ast           *ast.Package
+	dir           string
+	fset          *token.FileSet
+	valueGroups   []*ValueGroup
+	funcs         []*Method
+	typesPkg      *types.Package
+	typesInfo     *types.Info
+	platforms     []string
+	filePlatforms map[string][]string
+}
 
-			file.SourceLineCount += 1
+// Adds a parsed file to the package.  The source it was parsed from is used for line counts, and is
+// only read from disk if not given.
+func (self *Package) addFile(fname string, astfile *ast.File, src []byte) error {
+	if src == nil {
+		if data, err := os.ReadFile(fname); err == nil {
+			src = data
+		} else {
+			return fmt.Errorf("unreadable source %q: %v", fname, err)
 		}
+	}
 
-		if err := file.parse(); err == nil {
-			if file.MainFunction {
-				self.MainFunction = true
-			}
+	file := &File{
+		Name:      filepath.Base(fname),
+		Package:   self,
+		Size:      int64(len(src)),
+		Platforms: self.filePlatforms[filepath.Base(fname)],
+		ast:       astfile,
+	}
 
-			self.Files = append(self.Files, file)
-			self.recalcTotals()
-			return nil
-		} else {
-			return fmt.Errorf("%s: %v", file.Name, err)
+	file.LineCount, file.SourceLineCount = countLines(src)
+
+	if err := file.parse(); err == nil {
+		if file.MainFunction {
+			self.MainFunction = true
 		}
+
+		self.Files = append(self.Files, file)
+		self.recalcTotals()
+		return nil
 	} else {
-		return fmt.Errorf("unreadable source %q: %v", fname, err)
+		return fmt.Errorf("%s: %v", file.Name, err)
 	}
 }
 
+// Returns whether a declaration with the given name is documented.
+func (self *Package) documents(name string) bool {
+	return name != `_` && (self.IncludesUnexported || ast.IsExported(name))
+}
+
 func (self *Package) recalcTotals() {
 	self.CommentWordCount = 0
 	self.LineCount = 0
@@ -157,6 +190,12 @@ func (self *Package) recalcTotals() {
 			funcCoverage = append(funcCoverage, mathutil.ClampUpper(1.0, (float64(wc)/IdealWordCountFunc)))
 		}
 
+		for _, m := range typ.InterfaceMethods {
+			wc := wordcount(m.Comment)
+			self.CommentWordCount += wc
+			funcCoverage = append(funcCoverage, mathutil.ClampUpper(1.0, (float64(wc)/IdealWordCountFunc)))
+		}
+
 		for _, f := range typ.Fields {
 			wc := wordcount(f.Comment)
 			self.CommentWordCount += wc
@@ -170,10 +209,16 @@ func (self *Package) recalcTotals() {
 		funcCoverage = append(funcCoverage, mathutil.ClampUpper(1.0, (float64(wc)/IdealWordCountFunc)))
 	}
 
-	for _, v := range self.Variables {
-		wc := wordcount(v.Comment)
-		self.CommentWordCount += wc
-		varCoverage = append(varCoverage, mathutil.ClampUpper(1.0, (float64(wc)/IdealWordCountVar)))
+	for _, group := range self.valueGroups {
+		if group.Immutable {
+			continue
+		}
+
+		for _, v := range group.Values {
+			wc := wordcount(v.Comment)
+			self.CommentWordCount += wc
+			varCoverage = append(varCoverage, mathutil.ClampUpper(1.0, (float64(wc)/IdealWordCountVar)))
+		}
 	}
 
 	var agg stats.Float64Data
@@ -214,6 +259,221 @@ func (self *Package) recalcTotals() {
 	self.CommentWordCount += wordcount(self.Synopsis)
 }
 
+// Type-checks the given package to find the value (and kind) of every package-level constant,
+// including those with implicit iota expressions.  This must happen before go/doc removes
+// unexported declarations from the AST (which it does in place unless given doc.AllDecls, whether
+// or not doc.PreserveAST is), since that shifts the iota of the constants that remain.
+// Without full type information, this is a best-effort attempt at checking just this package.
+func checkConstants(fset *token.FileSet, pkg *ast.Package, typed *packages.Package, imp types.Importer) map[string]*types.Const {
+	if typed != nil && typed.TypesInfo != nil {
+		return packageConstants(typed.Types, typed.TypesInfo)
+	}
+
+	var fnames []string
+	var files []*ast.File
+
+	for fname := range pkg.Files {
+		fnames = append(fnames, fname)
+	}
+
+	sort.Strings(fnames)
+
+	for _, fname := range fnames {
+		files = append(files, pkg.Files[fname])
+	}
+
+	var info = &types.Info{
+		Defs: make(map[*ast.Ident]types.Object),
+	}
+
+	var conf = types.Config{
+		Importer: imp,
+		Error: func(err error) {
+			log.Debugf("typecheck %s: %v", pkg.Name, err)
+		},
+	}
+
+	tpkg, _ := conf.Check(pkg.Name, fset, files, info)
+
+	return packageConstants(tpkg, info)
+}
+
+// Returns the importer that checkConstants resolves imported packages with.  Offline, the go command
+// that locates them runs in the same environment as for a type-checked load, so that nothing is
+// downloaded; imports that aren't available locally are left unresolved.
+func constantsImporter(pkgdir string, options *ScanOptions) types.Importer {
+	if options.Offline {
+		return &offlineImporter{
+			dir:      pkgdir,
+			env:      goCommandEnv(options),
+			packages: make(map[string]*types.Package),
+		}
+	}
+
+	return importer.Default()
+}
+
+// Imports packages from their export data via go/packages, with the go command kept offline.
+type offlineImporter struct {
+	dir      string
+	env      []string
+	packages map[string]*types.Package
+}
+
+func (self *offlineImporter) Import(path string) (*types.Package, error) {
+	if tpkg, ok := self.packages[path]; ok {
+		return tpkg, nil
+	}
+
+	var config = &packages.Config{
+		Mode: packages.NeedName | packages.NeedTypes,
+		Dir:  self.dir,
+		Env:  self.env,
+	}
+
+	if lpkgs, err := packages.Load(config, path); err == nil {
+		if len(lpkgs) != 1 || lpkgs[0].Types == nil || len(lpkgs[0].Errors) > 0 {
+			return nil, fmt.Errorf("cannot import %s offline", path)
+		}
+
+		self.packages[path] = lpkgs[0].Types
+
+		return lpkgs[0].Types, nil
+	} else {
+		return nil, err
+	}
+}
+
+// Returns the package-level constants defined in a type-checked package, by name.
+func packageConstants(tpkg *types.Package, info *types.Info) map[string]*types.Const {
+	var consts = make(map[string]*types.Const)
+
+	if tpkg != nil {
+		for ident, obj := range info.Defs {
+			if c, ok := obj.(*types.Const); ok && c.Parent() == tpkg.Scope() {
+				consts[ident.Name] = c
+			}
+		}
+	}
+
+	return consts
+}
+
+// Sets the value (and kind) of every constant in the package from the results of checkConstants.
+func (self *Package) evaluateConstants(consts map[string]*types.Const) {
+	for _, group := range self.valueGroups {
+		for i, value := range group.Values {
+			if c, ok := consts[value.Name]; ok && value.Immutable {
+				value.Value, value.Kind = constantValueString(c.Val())
+
+				// constants in iota blocks can inherit their type from a previous line
+				if basic, ok := c.Type().(*types.Basic); value.Type == `` && (!ok || basic.Info()&types.IsUntyped == 0) {
+					value.Type = types.TypeString(c.Type(), types.RelativeTo(c.Pkg()))
+				}
+
+				group.Values[i] = value
+			}
+		}
+	}
+}
+
+// Places each function with the type it belongs to.  This happens once every file in the package
+// has been parsed, so that the outcome doesn't depend on the order the files were read in.
+func (self *Package) associateFunctions() {
+	for _, method := range self.funcs {
+		if method.IsPackageLevel {
+			var constructorTypeName string
+
+			if len(method.Returns) > 0 {
+				constructorTypeName = self.describesDeclaredType(method.Returns[0])
+			}
+
+			// package-level functions whose first return argument type has been declared in this
+			// package are put with that type's methods
+			if typ, ok := self.Types[constructorTypeName]; ok {
+				method.Parent = typ
+				typ.Methods = append(typ.Methods, method)
+			} else if strings.HasPrefix(method.Name, `Test`) {
+				self.Tests = append(self.Tests, method)
+			} else if strings.HasPrefix(method.Name, `Example`) {
+				// examples are extracted from the test files as a whole (see addExamples)
+				continue
+			} else {
+				self.Functions = append(self.Functions, method)
+			}
+		} else {
+			var parent = self.Types[method.receiverTypeName]
+
+			// the receiver's declaration wasn't parsed (e.g.: it's in a file excluded by build constraints),
+			// so all we know about it is its name.
+			if parent == nil {
+				parent = new(Type)
+				parent.Name = method.receiverTypeName
+				self.Types[parent.Name] = parent
+			}
+
+			method.Parent = parent
+			parent.Methods = append(parent.Methods, method)
+		}
+	}
+}
+
+func (self *Package) describesDeclaredType(arg Arg) string {
+	if arg.resolved != nil && self.typesPkg != nil {
+		if named := namedType(arg.resolved); named != nil && named.Obj().Pkg() == self.typesPkg {
+			return named.Obj().Name()
+		}
+
+		return ``
+	}
+
+	var typestr = strings.TrimPrefix(arg.Type, `*`)
+
+	// generic instantiations (e.g.: List[T]) describe the base type
+	if i := strings.Index(typestr, `[`); i > 0 {
+		typestr = typestr[:i]
+	}
+
+	for typeName, _ := range self.Types {
+
+		if typeName == typestr {
+			return typeName
+		}
+	}
+
+	return ``
+}
+
+// Documents const and var blocks alongside the type they predominantly declare values of (e.g.:
+// enumerations), and everything else at the package level.
+func (self *Package) associateValues() {
+	self.Constants = nil
+	self.Variables = nil
+	self.ConstantGroups = nil
+	self.VariableGroups = nil
+
+	for _, typ := range self.Types {
+		typ.Constants = nil
+		typ.Variables = nil
+	}
+
+	for _, group := range self.valueGroups {
+		if typ, ok := self.Types[group.TypeName]; ok {
+			if group.Immutable {
+				typ.Constants = append(typ.Constants, group)
+			} else {
+				typ.Variables = append(typ.Variables, group)
+			}
+		} else if group.Immutable {
+			self.ConstantGroups = append(self.ConstantGroups, group)
+			self.Constants = append(self.Constants, group.Values...)
+		} else {
+			self.VariableGroups = append(self.VariableGroups, group)
+			self.Variables = append(self.Variables, group.Values...)
+		}
+	}
+}
+
 func (self *Package) sortObjects() {
 	sort.Slice(self.Files, func(i int, j int) bool {
 		return self.Files[i].Name < self.Files[j].Name
@@ -227,6 +487,18 @@ func (self *Package) sortObjects() {
 		return self.Variables[i].Name < self.Variables[j].Name
 	})
 
+	sortValueGroups(self.ConstantGroups)
+	sortValueGroups(self.VariableGroups)
+
+	for _, typ := range self.Types {
+		sortValueGroups(typ.Constants)
+		sortValueGroups(typ.Variables)
+
+		sort.Slice(typ.Methods, func(i int, j int) bool {
+			return typ.Methods[i].Name < typ.Methods[j].Name
+		})
+	}
+
 	sort.Slice(self.Functions, func(i int, j int) bool {
 		return self.Functions[i].Name < self.Functions[j].Name
 	})
@@ -240,84 +512,173 @@ func (self *Package) sortObjects() {
 	})
 }
 
-func LoadPackage(parentDir string) (*Package, error) {
-	return loadPackage(parentDir, ``)
+func LoadPackage(parentDir string, options *ScanOptions) (*Package, error) {
+	if options == nil {
+		options = new(ScanOptions)
+	}
+
+	var scan = *options
+	scan.filter = newDirFilter(parentDir, &scan)
+
+	if scan.Jobs > 0 {
+		scan.jobs = make(chan struct{}, scan.Jobs)
+	} else {
+		scan.jobs = make(chan struct{}, runtime.NumCPU())
+	}
+
+	scan.cache = newScanCache(&scan)
+	scan.repositories = newRepositoryCache()
+
+	return loadPackage(parentDir, ``, &scan)
+}
+
+func loadPackage(pkgdir string, parentName string, options *ScanOptions) (*Package, error) {
+	// a job slot is only held while analyzing this package, so that subpackages (which wait for
+	// slots of their own) can't deadlock waiting on their parents.
+	options.jobs <- struct{}{}
+	p, err := options.cache.analyzePackage(pkgdir, parentName, options)
+	<-options.jobs
+
+	if p == nil || err != nil {
+		return p, err
+	}
+
+	if err := p.loadSubpackages(pkgdir, options); err != nil {
+		return nil, err
+	}
+
+	return p, nil
+}
+
+// Loads the packages in the subdirectories of the given directory concurrently, keeping them in
+// directory order.  The packages beneath subdirectories that don't contain a package of their own
+// (e.g.: "cmd") are loaded as subpackages of this one.
+func (self *Package) loadSubpackages(pkgdir string, options *ScanOptions) error {
+	if entries, err := ioutil.ReadDir(pkgdir); err == nil {
+		var subdirs []string
+
+		for _, entry := range entries {
+			if entry.IsDir() {
+				if path := filepath.Join(pkgdir, entry.Name()); options.filter.allows(path) {
+					subdirs = append(subdirs, path)
+				}
+			}
+		}
+
+		var subpkgs = make([]*Package, len(subdirs))
+		var errs = make([]error, len(subdirs))
+		var wg sync.WaitGroup
+
+		for i, path := range subdirs {
+			wg.Add(1)
+
+			go func(i int, path string) {
+				defer wg.Done()
+				subpkgs[i], errs[i] = loadPackage(path, self.ImportPath, options)
+			}(i, path)
+		}
+
+		wg.Wait()
+
+		for i, path := range subdirs {
+			if errs[i] != nil {
+				if !options.Tolerant {
+					return fmt.Errorf("dir %s: %v", path, errs[i])
+				} else if diags, ok := errs[i].(diagnosticsError); ok {
+					for _, diag := range diags {
+						diag.Package = path
+						self.addDiagnostics(diag)
+					}
+				} else {
+					self.addDiagnostics(Diagnostic{
+						Severity: SeverityError,
+						Package:  path,
+						Message:  errs[i].Error(),
+					})
+				}
+			} else if subpkgs[i] != nil {
+				self.Packages = append(self.Packages, subpkgs[i])
+			} else if err := self.loadSubpackages(path, options); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	} else {
+		return err
+	}
 }
 
-func loadPackage(pkgdir string, parentName string) (*Package, error) {
+// Parses and documents the package in the given directory, not including its subpackages.
+func analyzePackage(pkgdir string, parentName string, options *ScanOptions) (*Package, error) {
 	log.Infof("load package from: %s", pkgdir)
 	fset := token.NewFileSet()
 
-	if pkgs, err := parser.ParseDir(
-		fset,
-		pkgdir,
-		nil,
-		(parser.ParseComments | parser.DeclarationErrors | parser.AllErrors),
-	); err == nil {
-		for _, pkg := range pkgs {
-			pkgDoc := doc.New(pkg, pkgdir, doc.PreserveAST)
+	if dir, err := parsePackageDir(fset, pkgdir, options); err == nil {
+		if pkg := dir.Package; pkg != nil {
+			var imp = constantsImporter(pkgdir, options)
+			var consts = checkConstants(fset, pkg, dir.typed, imp)
+			var mode = doc.PreserveAST
+
+			// PreserveAST only keeps the function bodies; without AllDecls, unexported declarations and
+			// fields are still removed from the AST, which is how structs come to be shown with a
+			// "contains filtered or unexported fields" comment.
+			if options.Unexported {
+				mode |= doc.AllDecls
+			}
+
+			pkgDoc := doc.New(pkg, pkgdir, mode)
 
 			p := new(Package)
 			p.ast = pkg
+			p.fset = fset
 			p.Name = pkgDoc.Name
 			p.Synopsis = pkgDoc.Doc
-			p.ImportPath = pkgDoc.ImportPath
-			p.CanonicalImportPath = p.ImportPath
-			p.ParentPackage = parentName
-
-			if abs, _ := filepath.Abs(pkgdir); err == nil {
-				if deducedImportBase, err := GetImportPathFromDir(abs, locateSourceRoot(abs)); err == nil {
-					if p.ImportPath == `.` {
-						p.ImportPath = p.Name
-						p.CanonicalImportPath = deducedImportBase
-					} else {
-						p.CanonicalImportPath = filepath.Join(deducedImportBase, p.ImportPath)
-					}
+			p.Command = (p.Name == `main`)
 
-					if rroot, err := vcs.RepoRootForImportPath(p.CanonicalImportPath, false); err == nil {
-						p.URL = rroot.Repo
-					} else {
-						return nil, fmt.Errorf("bad url: %v", err)
-					}
-				} else {
-					return nil, fmt.Errorf("bad import path: %v", err)
-				}
-			} else {
-				return nil, fmt.Errorf("bad path: %v", err)
+			if err := p.setLocation(pkgdir, parentName, options); err != nil {
+				return nil, err
 			}
 
+			if typed := dir.typed; typed != nil {
+				p.CanonicalImportPath = typed.PkgPath
+				p.typesPkg = typed.Types
+				p.typesInfo = typed.TypesInfo
+			}
+
+			p.IgnoredFiles = dir.IgnoredFiles
+			p.IncludesUnexported = options.Unexported
+			p.addDiagnostics(dir.diagnostics...)
+			p.platforms = dir.platforms
+			p.filePlatforms = dir.filePlatforms
 			p.Functions = make([]*Method, 0)
 			p.Types = make(map[string]*Type)
 			p.Files = make([]*File, 0)
 
-			for fname, f := range pkg.Files {
-				if err := p.addFile(fname, f); err != nil {
-					return nil, err
-				}
+			if err := p.addFiles(pkg, dir.sources, options.Tolerant); err != nil {
+				return nil, err
 			}
 
-			if entries, err := ioutil.ReadDir(pkgdir); err == nil {
-				for _, entry := range entries {
-					if entry.IsDir() {
-						path := filepath.Join(pkgdir, entry.Name())
-
-						if subpkg, err := loadPackage(path, p.ImportPath); err == nil {
-							if subpkg != nil {
-								p.Packages = append(p.Packages, subpkg)
-							}
-						} else {
-							return nil, fmt.Errorf("dir %s: %v", path, err)
-						}
-					}
+			p.resolvePlatforms()
+			p.associateFunctions()
+			p.evaluateConstants(consts)
+			p.associateValues()
+
+			if dir.TestPackage != nil {
+				if err := p.loadTestPackage(fset, dir.TestPackage, dir.sources, imp, options.Tolerant); err != nil {
+					return nil, fmt.Errorf("test package: %v", err)
 				}
-			} else {
-				return nil, err
 			}
 
+			p.addExamples(fset, testFiles(pkg, dir.TestPackage))
 			p.sortObjects()
+			p.adoptUnmatchedExamples(p.associateExamples())
 			p.recalcTotals()
 
 			return p, nil
+		} else if errs := dir.errors(); len(errs) > 0 {
+			// every file was skipped, so there's no package to report the problems with
+			return nil, errs
 		}
 
 		return nil, nil
@@ -326,6 +687,390 @@ func loadPackage(pkgdir string, parentName string) (*Package, error) {
 	}
 }
 
+const parserMode = (parser.ParseComments | parser.DeclarationErrors | parser.AllErrors)
+
+// The Go source files in a single directory, split into the package being documented, its external
+// test package (if any), and the files excluded by build constraints.
+type sourceDir struct {
+	Package       *ast.Package
+	TestPackage   *ast.Package
+	IgnoredFiles  []string
+	typed         *packages.Package
+	platforms     []string
+	filePlatforms map[string][]string
+	sources       map[string][]byte
+	sourcesLock   sync.Mutex
+	diagnostics   []Diagnostic
+}
+
+// Parses the Go source files in the given directory.  A file is included if the build constraints
+// of any of the scanned platforms select it.  If options.TypeCheck is set, the package is loaded via
+// go/packages so that the syntax trees are accompanied by full type information.
+func parsePackageDir(fset *token.FileSet, pkgdir string, options *ScanOptions) (*sourceDir, error) {
+	var dir = new(sourceDir)
+	var primary string
+	var included = make(map[string]bool)
+	var ignored = make(map[string]bool)
+	var platforms []Platform
+
+	if p, err := scanPlatforms(options); err == nil {
+		platforms = p
+	} else {
+		return nil, err
+	}
+
+	dir.filePlatforms = make(map[string][]string)
+	dir.sources = make(map[string][]byte)
+
+	// use the same rules as the go command to decide which files make up the package
+	for _, platform := range platforms {
+		dir.platforms = append(dir.platforms, platform.String())
+
+		if bpkg, err := platform.buildContext().ImportDir(pkgdir, 0); err == nil {
+			if primary == `` {
+				primary = bpkg.Name
+			}
+
+			for _, set := range [][]string{bpkg.GoFiles, bpkg.CgoFiles, bpkg.TestGoFiles, bpkg.XTestGoFiles} {
+				for _, name := range set {
+					included[name] = true
+					dir.filePlatforms[name] = append(dir.filePlatforms[name], platform.String())
+				}
+			}
+
+			for _, name := range bpkg.IgnoredGoFiles {
+				ignored[name] = true
+			}
+		} else if _, ok := err.(*build.NoGoError); ok {
+			continue
+		} else {
+			// fall back to parsing everything, with no platform information
+			dir.diagnostics = append(dir.diagnostics, errorDiagnostics(SeverityWarning, ``, err)...)
+			included = nil
+			dir.platforms = nil
+			dir.filePlatforms = nil
+			break
+		}
+	}
+
+	if included != nil {
+		if len(included) == 0 {
+			return dir, nil
+		}
+
+		for name := range ignored {
+			if !included[name] {
+				dir.IgnoredFiles = append(dir.IgnoredFiles, name)
+			}
+		}
+
+		sort.Strings(dir.IgnoredFiles)
+	}
+
+	for _, name := range dir.IgnoredFiles {
+		log.Infof("%s: excluded by build constraints", filepath.Join(pkgdir, name))
+	}
+
+	if options.TypeCheck {
+		return dir, dir.load(fset, pkgdir, primary, platforms[0], options)
+	}
+
+	if pkgs, err := dir.parse(fset, pkgdir, options.Tolerant, func(name string) bool {
+		return included == nil || included[name]
+	}); err == nil {
+		if primary == `` {
+			primary = choosePrimaryPackage(pkgdir, pkgs)
+		}
+
+		for name, pkg := range pkgs {
+			switch name {
+			case primary:
+				dir.Package = pkg
+			case primary + `_test`:
+				dir.TestPackage = pkg
+			default:
+				dir.diagnostics = append(dir.diagnostics, Diagnostic{
+					Severity: SeverityWarning,
+					Message:  fmt.Sprintf("ignoring package %s", name),
+				})
+
+				for fname := range pkg.Files {
+					dir.IgnoredFiles = append(dir.IgnoredFiles, filepath.Base(fname))
+				}
+			}
+		}
+
+		sort.Strings(dir.IgnoredFiles)
+
+		return dir, nil
+	} else {
+		return nil, err
+	}
+}
+
+// Parses the Go files in the given directory that pass the filter, grouped by package name.  This is
+// equivalent to parser.ParseDir, but keeps each file's source for later use.  If tolerant, files that
+// can't be read or parsed are skipped and recorded as diagnostics.
+func (self *sourceDir) parse(fset *token.FileSet, pkgdir string, tolerant bool, filter func(string) bool) (map[string]*ast.Package, error) {
+	var pkgs = make(map[string]*ast.Package)
+
+	if entries, err := ioutil.ReadDir(pkgdir); err == nil {
+		for _, entry := range entries {
+			if entry.IsDir() || !strings.HasSuffix(entry.Name(), `.go`) || !filter(entry.Name()) {
+				continue
+			}
+
+			fname := filepath.Join(pkgdir, entry.Name())
+
+			if src, err := os.ReadFile(fname); err == nil {
+				if file, err := parser.ParseFile(fset, fname, src, parserMode); err == nil {
+					pkg, ok := pkgs[file.Name.Name]
+
+					if !ok {
+						pkg = &ast.Package{
+							Name:  file.Name.Name,
+							Files: make(map[string]*ast.File),
+						}
+
+						pkgs[pkg.Name] = pkg
+					}
+
+					pkg.Files[fname] = file
+					self.sources[fname] = src
+				} else if tolerant {
+					self.diagnostics = append(self.diagnostics, errorDiagnostics(SeverityError, fname, err)...)
+				} else {
+					return nil, err
+				}
+			} else if tolerant {
+				self.diagnostics = append(self.diagnostics, errorDiagnostics(SeverityError, fname, err)...)
+			} else {
+				return nil, err
+			}
+		}
+
+		return pkgs, nil
+	} else {
+		return nil, err
+	}
+}
+
+// Loads the package (and its external test package) in the given directory via go/packages.
+// Only the files selected for the given platform are type-checked.
+func (self *sourceDir) load(fset *token.FileSet, pkgdir string, primary string, platform Platform, options *ScanOptions) error {
+	var config = &packages.Config{
+		Mode:  packages.LoadSyntax,
+		Dir:   pkgdir,
+		Fset:  fset,
+		Tests: true,
+		Env:   goCommandEnv(options, `GOOS=`+platform.OS, `GOARCH=`+platform.Arch),
+
+		// keep hold of each file's source for line counts
+		ParseFile: func(fset *token.FileSet, fname string, src []byte) (*ast.File, error) {
+			self.sourcesLock.Lock()
+			self.sources[fname] = src
+			self.sourcesLock.Unlock()
+
+			return parser.ParseFile(fset, fname, src, parserMode)
+		},
+	}
+
+	if len(platform.Tags) > 0 {
+		config.BuildFlags = []string{`-tags=` + strings.Join(platform.Tags, `,`)}
+	}
+
+	if lpkgs, err := packages.Load(config, `.`); err == nil {
+		var test *packages.Package
+
+		for _, lpkg := range lpkgs {
+			if len(lpkg.Syntax) == 0 || strings.HasSuffix(lpkg.PkgPath, `.test`) {
+				continue
+			}
+
+			pkg := &ast.Package{
+				Name:  lpkg.Name,
+				Files: make(map[string]*ast.File),
+			}
+
+			for i, file := range lpkg.Syntax {
+				pkg.Files[lpkg.CompiledGoFiles[i]] = file
+			}
+
+			if primary == `` {
+				primary = strings.TrimSuffix(lpkg.Name, `_test`)
+			}
+
+			switch lpkg.Name {
+			case primary:
+				// prefer the variant of the package that includes its in-package tests, e.g.:
+				// "example.com/pkg [example.com/pkg.test]"
+				if self.typed == nil || strings.HasSuffix(lpkg.ID, `.test]`) {
+					self.Package = pkg
+					self.typed = lpkg
+				}
+			case primary + `_test`:
+				self.TestPackage = pkg
+				test = lpkg
+			}
+		}
+
+		// type errors don't prevent a package from being documented, but they're worth knowing about
+		for _, lpkg := range []*packages.Package{self.typed, test} {
+			if lpkg != nil {
+				for _, perr := range lpkg.Errors {
+					self.diagnostics = append(self.diagnostics, packagesErrorDiagnostic(perr))
+				}
+			}
+		}
+
+		return nil
+	} else {
+		return err
+	}
+}
+
+// Deterministically picks which of several packages found in a directory is the one being
+// documented: the one named after the directory, otherwise the one with the most files.
+func choosePrimaryPackage(pkgdir string, pkgs map[string]*ast.Package) string {
+	var names []string
+	var dirname string
+
+	if abs, err := filepath.Abs(pkgdir); err == nil {
+		dirname = filepath.Base(abs)
+	}
+
+	for name := range pkgs {
+		if !strings.HasSuffix(name, `_test`) {
+			names = append(names, name)
+		}
+	}
+
+	// a directory with only an external test package
+	if len(names) == 0 {
+		return ``
+	}
+
+	sort.Strings(names)
+
+	var primary = names[0]
+
+	for _, name := range names {
+		if name == dirname {
+			return name
+		} else if len(pkgs[name].Files) > len(pkgs[primary].Files) {
+			primary = name
+		}
+	}
+
+	return primary
+}
+
+// Adds the given package's files in name order.  If tolerant, files that can't be documented are
+// skipped and recorded as diagnostics.
+func (self *Package) addFiles(pkg *ast.Package, sources map[string][]byte, tolerant bool) error {
+	var fnames []string
+
+	for fname := range pkg.Files {
+		fnames = append(fnames, fname)
+	}
+
+	sort.Strings(fnames)
+
+	for _, fname := range fnames {
+		if err := self.addFile(fname, pkg.Files[fname], sources[fname]); err != nil {
+			if tolerant {
+				self.addDiagnostics(errorDiagnostics(SeverityError, fname, err)...)
+			} else {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// Loads the external test package (i.e.: "package foo_test") accompanying this package.  Its tests
+// and examples are documented alongside this package's own.
+func (self *Package) loadTestPackage(fset *token.FileSet, pkg *ast.Package, sources map[string][]byte, imp types.Importer, tolerant bool) error {
+	var test = new(Package)
+
+	test.ast = pkg
+	test.fset = fset
+	test.Name = pkg.Name
+	test.Functions = make([]*Method, 0)
+	test.Types = make(map[string]*Type)
+	test.Files = make([]*File, 0)
+	test.platforms = self.platforms
+	test.filePlatforms = self.filePlatforms
+	test.IncludesUnexported = self.IncludesUnexported
+
+	if err := test.addFiles(pkg, sources, tolerant); err != nil {
+		return err
+	}
+
+	test.resolvePlatforms()
+	test.associateFunctions()
+	test.evaluateConstants(checkConstants(fset, pkg, nil, imp))
+	test.associateValues()
+	test.sortObjects()
+	test.recalcTotals()
+
+	self.Tests = append(self.Tests, test.Tests...)
+
+	// the test package isn't documented on its own, so its problems are reported with this package's
+	self.Diagnostics = append(self.Diagnostics, test.Diagnostics...)
+	test.Diagnostics = nil
+	self.TestPackage = test
+	self.setTestPackageLocation()
+
+	return nil
+}
+
+// Sets the import paths and repository URL of the package in the given directory.  These depend only
+// on where the package is, not on what it contains.
+func (self *Package) setLocation(pkgdir string, parentName string, options *ScanOptions) error {
+	self.dir = pkgdir
+	self.ImportPath = pkgdir
+	self.ParentPackage = parentName
+
+	if self.ImportPath == `.` {
+		self.ImportPath = self.Name
+	}
+
+	if importPath, err := GetImportPathFromDir(pkgdir); err == nil {
+		self.CanonicalImportPath = importPath
+	} else {
+		return fmt.Errorf("bad import path: %v", err)
+	}
+
+	self.URL = repositoryURL(pkgdir, self.CanonicalImportPath, options)
+	self.ImportableFrom, self.Internal = internalImportRoot(self.CanonicalImportPath)
+	self.setTestPackageLocation()
+
+	return nil
+}
+
+func (self *Package) setTestPackageLocation() {
+	if test := self.TestPackage; test != nil {
+		test.ImportPath = self.ImportPath + `_test`
+		test.CanonicalImportPath = self.CanonicalImportPath + `_test`
+		test.ParentPackage = self.ParentPackage
+		test.URL = self.URL
+	}
+}
+
+// Returns the environment to run the go command in, with the given additional variables.  Offline,
+// the go command is kept from downloading missing dependencies or toolchains.
+func goCommandEnv(options *ScanOptions, env ...string) []string {
+	env = append(os.Environ(), env...)
+
+	if options.Offline {
+		env = append(env, `GOPROXY=off`, `GOTOOLCHAIN=local`)
+	}
+
+	return env
+}
+
 func parseFilterGoNoTests(stat os.FileInfo) bool {
 	filename := stat.Name()
 	filename = strings.ToLower(filename)
@@ -341,27 +1086,85 @@ func parseFilterGoNoTests(stat os.FileInfo) bool {
 	return false
 }
 
+// Returns the total number of lines in the given source, and the number of those that aren't blank
+// or comment-only.
+func countLines(src []byte) (lines int, sloc int) {
+	if len(src) == 0 {
+		return
+	}
+
+	for _, line := range strings.Split(strings.TrimSuffix(string(src), "\n"), "\n") {
+		lines += 1
+
+		if line = strings.TrimSpace(line); line == `` || strings.HasPrefix(line, `//`) {
+			continue
+		}
+
+		sloc += 1
+	}
+
+	return
+}
+
 func wordcount(s string) int {
 	words := stringutil.SplitWords(s)
 	words = sliceutil.CompactString(words)
 	return len(words)
 }
 
-func GetImportPathFromDir(dir string, srcRoot string) (string, error) {
-	if _, root, err := vcs.FromDir(dir, srcRoot); err == nil {
-		return root, nil
-	} else {
-		return ``, err
+// Returns the Go representation of a constant value, along with the kind of value it is.
+func constantValueString(val constant.Value) (string, string) {
+	switch val.Kind() {
+	case constant.String:
+		return constant.StringVal(val), `string`
+	case constant.Bool:
+		return val.ExactString(), `bool`
+	case constant.Int:
+		return val.ExactString(), `int`
+	case constant.Float:
+		f, _ := constant.Float64Val(val)
+		return strconv.FormatFloat(f, 'g', -1, 64), `float`
+	case constant.Complex:
+		return val.String(), `complex`
+	default:
+		return ``, ``
 	}
 }
 
-func locateSourceRoot(dir string) string {
-	switch filepath.Base(dir) {
-	case `.`, ``:
+// Orders value blocks by the name of the first value each declares.
+func sortValueGroups(groups []*ValueGroup) {
+	sort.SliceStable(groups, func(i int, j int) bool {
+		return groups[i].Values[0].Name < groups[j].Values[0].Name
+	})
+}
+
+// Returns the type-checked type of the given expression, if type information is available.
+func (self *Package) typeOf(expr ast.Expr) types.Type {
+	if self == nil || self.typesInfo == nil || expr == nil {
+		return nil
+	}
+
+	return self.typesInfo.TypeOf(expr)
+}
+
+// Returns the named type the given type refers to, dereferencing pointers.
+func namedType(t types.Type) *types.Named {
+	if ptr, ok := t.(*types.Pointer); ok {
+		t = ptr.Elem()
+	}
+
+	if named, ok := t.(*types.Named); ok {
+		return named
+	}
+
+	return nil
+}
+
+// Returns the fully-qualified representation of the given type, e.g.: "*github.com/x/y.Thing"
+func resolvedTypeString(t types.Type) string {
+	if t == nil {
 		return ``
-	case `src`:
-		return dir
-	default:
-		return locateSourceRoot(filepath.Dir(dir))
 	}
+
+	return types.TypeString(t, nil)
 }
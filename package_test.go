@@ -0,0 +1,195 @@
+package main
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+)
+
+// Returns "name=value" for each of the given values.
+func valueStrings(values []Value) (out []string) {
+	for _, value := range values {
+		out = append(out, value.Name+`=`+value.Value)
+	}
+
+	return
+}
+
+// Constants are evaluated as the compiler would, whether or not the package is type-checked.
+func TestConstantValues(t *testing.T) {
+	var files = map[string]string{
+		`consts.go`: `package consts
+
+import "time"
+
+type Weekday int
+
+const (
+	Sunday Weekday = iota
+	Monday
+	tuesday
+	Wednesday
+)
+
+type Size uint64
+
+const (
+	_       = iota
+	KB Size = 1 << (10 * iota)
+	MB
+)
+
+const (
+	Timeout = 5 * time.Second
+	Ratio   = 3.0 / 2
+	Name    = "consts" + "." + "go"
+	Enabled = !false
+	Long    = "aaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa" + "b"
+)
+`,
+	}
+
+	for _, typecheck := range []bool{false, true} {
+		t.Run(fmt.Sprintf("typecheck=%v", typecheck), func(t *testing.T) {
+			var pkg = scanTestModule(t, files, &ScanOptions{NoCache: true, TypeCheck: typecheck}).Package
+			var got []string
+
+			// the unexported constant still counts towards iota
+			got = valueStrings(pkg.Types[`Weekday`].Constants[0].Values)
+
+			if want := `Sunday=0 Monday=1 Wednesday=3`; strings.Join(got, ` `) != want {
+				t.Errorf("Weekday constants = %q, want %q", got, want)
+			}
+
+			got = valueStrings(pkg.Types[`Size`].Constants[0].Values)
+
+			if want := `KB=1024 MB=1048576`; strings.Join(got, ` `) != want {
+				t.Errorf("Size constants = %q, want %q", got, want)
+			}
+
+			var kinds = make(map[string]string)
+			var types = make(map[string]string)
+
+			for _, value := range pkg.Constants {
+				kinds[value.Name] = value.Kind + `:` + value.Value
+				types[value.Name] = value.Type
+			}
+
+			for name, want := range map[string]string{
+				`Timeout`: `int:5000000000`,
+				`Ratio`:   `float:1.5`,
+				`Name`:    `string:consts.go`,
+				`Enabled`: `bool:true`,
+				`Long`:    `string:` + strings.Repeat(`a`, 80) + `b`,
+			} {
+				if kinds[name] != want {
+					t.Errorf("%s = %q, want %q", name, kinds[name], want)
+				}
+			}
+
+			if types[`Timeout`] != `time.Duration` {
+				t.Errorf("Timeout has type %q, want time.Duration", types[`Timeout`])
+			}
+		})
+	}
+}
+
+// Blocks keep their grouping and doc comment, and are documented with the type they're mostly of.
+func TestValueGroups(t *testing.T) {
+	var pkg = scanTestModule(t, map[string]string{
+		`color.go`: `package color
+
+type Color int
+
+// The primary colors.
+const (
+	Red Color = iota
+	Green
+	Blue
+)
+
+// Limits.
+const (
+	Min = 0
+	Max = 255
+)
+
+const Black Color = -1
+
+// Defaults.
+var (
+	Background Color
+	Foreground = Red
+	Palette    []Color
+)
+`,
+	}, nil).Package
+
+	var color = pkg.Types[`Color`]
+	var groups []string
+
+	for _, group := range color.Constants {
+		groups = append(groups, strings.Join(valueStrings(group.Values), ` `)+` (`+group.Comment+`)`)
+	}
+
+	if want := `Black=-1 ()|Red=0 Green=1 Blue=2 (The primary colors.)`; strings.Join(groups, `|`) != want {
+		t.Errorf("Color constants = %q, want %q", strings.Join(groups, `|`), want)
+	}
+
+	if len(pkg.ConstantGroups) != 1 || pkg.ConstantGroups[0].Comment != `Limits.` || len(pkg.ConstantGroups[0].Values) != 2 {
+		t.Errorf("package constants = %+v, want the Limits block", pkg.ConstantGroups)
+	}
+
+	// only one of three variables is declared as a Color, so the block stays with the package
+	if len(color.Variables) != 0 || len(pkg.VariableGroups) != 1 || len(pkg.Variables) != 3 {
+		t.Errorf("variables = %d with Color, %d blocks with the package, want the Defaults block with the package", len(color.Variables), len(pkg.VariableGroups))
+	}
+}
+
+// Functions are associated with types declared in other files, regardless of the order the files
+// are read in.
+func TestConstructorAssociation(t *testing.T) {
+	var pkg = scanTestModule(t, map[string]string{
+		`a.go`: `package list
+
+func New() *List { return nil }
+
+func NewPair() (*Pair, error) { return nil, nil }
+
+func (l List) Len() int { return len(l) }
+
+func Parse(s string) (int, *List) { return 0, nil }
+`,
+		`b.go`: `package list
+
+type List []string
+
+type Pair struct{ A, B string }
+`,
+	}, nil).Package
+
+	var names = func(methods []*Method) (out []string) {
+		for _, method := range methods {
+			out = append(out, method.Name)
+		}
+
+		return
+	}
+
+	if got, want := strings.Join(names(pkg.Types[`List`].Methods), ` `), `Len New`; got != want {
+		t.Errorf("List methods = %q, want %q", got, want)
+	}
+
+	if got, want := strings.Join(names(pkg.Types[`Pair`].Methods), ` `), `NewPair`; got != want {
+		t.Errorf("Pair methods = %q, want %q", got, want)
+	}
+
+	if got, want := strings.Join(names(pkg.Functions), ` `), `Parse`; got != want {
+		t.Errorf("functions = %q, want %q", got, want)
+	}
+
+	// the method was read before its receiver's declaration
+	if got := pkg.Types[`List`].MetaType; got != `[]string` {
+		t.Errorf("List is a %q, want []string", got)
+	}
+}
@@ -0,0 +1,206 @@
+package main
+
+import (
+	"fmt"
+	"go/build"
+	"strings"
+
+	"github.com/ghetzel/go-stockutil/sliceutil"
+)
+
+// A GOOS/GOARCH combination (and any additional build tags) that packages are evaluated against.
+type Platform struct {
+	OS   string
+	Arch string
+	Tags []string
+}
+
+// Parses a platform specification of the form "GOOS/GOARCH[,tag,...]", e.g.: "linux/amd64,netgo".
+func ParsePlatform(spec string) (Platform, error) {
+	var platform Platform
+	var parts = strings.Split(strings.TrimSpace(spec), `,`)
+
+	if goos, goarch, ok := strings.Cut(parts[0], `/`); ok && goos != `` && goarch != `` {
+		platform.OS = goos
+		platform.Arch = goarch
+	} else {
+		return platform, fmt.Errorf("invalid platform %q: expected GOOS/GOARCH[,tag,...]", spec)
+	}
+
+	for _, tag := range parts[1:] {
+		if tag = strings.TrimSpace(tag); tag != `` {
+			platform.Tags = append(platform.Tags, tag)
+		}
+	}
+
+	return platform, nil
+}
+
+// Returns the platform that the current build environment targets.
+func DefaultPlatform() Platform {
+	return Platform{
+		OS:   build.Default.GOOS,
+		Arch: build.Default.GOARCH,
+		Tags: build.Default.BuildTags,
+	}
+}
+
+func (self Platform) String() string {
+	return strings.Join(append([]string{self.OS + `/` + self.Arch}, self.Tags...), `,`)
+}
+
+// Returns a build context that evaluates build constraints as if compiling for this platform.
+func (self Platform) buildContext() *build.Context {
+	var ctx = build.Default
+
+	ctx.GOOS = self.OS
+	ctx.GOARCH = self.Arch
+	ctx.BuildTags = self.Tags
+
+	// cgo is only assumed to be available for the host platform, unless asked for explicitly
+	if sliceutil.ContainsString(self.Tags, `cgo`) {
+		ctx.CgoEnabled = true
+	} else if self.OS != build.Default.GOOS || self.Arch != build.Default.GOARCH {
+		ctx.CgoEnabled = false
+	}
+
+	return &ctx
+}
+
+// Returns the platforms specified in the given options, or the default platform if none were.
+func scanPlatforms(options *ScanOptions) ([]Platform, error) {
+	var platforms []Platform
+
+	for _, spec := range options.Platforms {
+		if platform, err := ParsePlatform(spec); err == nil {
+			platforms = append(platforms, platform)
+		} else {
+			return nil, err
+		}
+	}
+
+	if len(platforms) == 0 {
+		platforms = append(platforms, DefaultPlatform())
+	}
+
+	// type information comes from loading each package once, so it only describes one platform
+	if options.TypeCheck && len(platforms) > 1 {
+		return nil, fmt.Errorf("type-checking evaluates a single platform, but %d were given", len(platforms))
+	}
+
+	return platforms, nil
+}
+
+// Combines two sets of platforms, ordered as they were specified when the package was loaded.
+func (self *Package) mergePlatforms(a []string, b []string) (merged []string) {
+	for _, platform := range self.platforms {
+		if sliceutil.ContainsString(a, platform) || sliceutil.ContainsString(b, platform) {
+			merged = append(merged, platform)
+		}
+	}
+
+	return
+}
+
+// Folds together declarations of the same function or value made for different platforms (e.g.: in
+// foo_linux.go and foo_windows.go), keeping the first one (in file name order) and recording all of
+// the platforms that declare it.  Declarations made for every platform are considered universal and
+// have their platforms cleared.
+func (self *Package) resolvePlatforms() {
+	var funcs = make(map[string]*Method)
+	var values = make(map[string]*Value)
+	var groups []*ValueGroup
+
+	for i := 0; i < len(self.funcs); {
+		var method = self.funcs[i]
+		var key = method.receiverTypeName + `.` + method.Name
+
+		if first, ok := funcs[key]; ok {
+			first.Platforms = self.mergePlatforms(first.Platforms, method.Platforms)
+			self.funcs = append(self.funcs[:i], self.funcs[i+1:]...)
+		} else {
+			funcs[key] = method
+			i++
+		}
+	}
+
+	for _, group := range self.valueGroups {
+		var kept []Value
+
+		for _, value := range group.Values {
+			if first, ok := values[value.Name]; ok {
+				first.Platforms = self.mergePlatforms(first.Platforms, value.Platforms)
+			} else {
+				kept = append(kept, value)
+			}
+		}
+
+		group.Values = kept
+
+		for i := range group.Values {
+			values[group.Values[i].Name] = &group.Values[i]
+		}
+
+		if len(group.Values) > 0 {
+			groups = append(groups, group)
+		}
+	}
+
+	self.valueGroups = groups
+
+	for _, file := range self.Files {
+		file.Platforms = self.universalPlatforms(file.Platforms)
+	}
+
+	for _, typ := range self.Types {
+		typ.resolveMemberPlatforms()
+		typ.Platforms = self.universalPlatforms(typ.Platforms)
+	}
+
+	for _, method := range self.funcs {
+		method.Platforms = self.universalPlatforms(method.Platforms)
+	}
+
+	for _, value := range values {
+		value.Platforms = self.universalPlatforms(value.Platforms)
+	}
+}
+
+// Clears the platforms of the fields and interface methods declared everywhere the type is.  Fields
+// declared together that don't share the same platforms are listed separately.
+func (self *Type) resolveMemberPlatforms() {
+	for _, field := range self.Fields {
+		if len(field.Platforms) >= len(self.Platforms) {
+			field.Platforms = nil
+		}
+	}
+
+	for _, method := range self.InterfaceMethods {
+		if len(method.Platforms) >= len(self.Platforms) {
+			method.Platforms = nil
+		}
+	}
+
+	for i, field := range self.Fields {
+		if i == 0 || field.Group != self.Fields[i-1].Group {
+			continue
+		}
+
+		if strings.Join(field.Platforms, `,`) != strings.Join(self.Fields[i-1].Platforms, `,`) {
+			for _, f := range self.Fields {
+				if f.Group == field.Group {
+					f.GroupNames = f.Name
+				}
+			}
+		}
+	}
+}
+
+// Returns nil if the given platforms are all of the platforms the package was loaded for.
+func (self *Package) universalPlatforms(platforms []string) []string {
+	if len(platforms) >= len(self.platforms) {
+		return nil
+	}
+
+	return platforms
+}
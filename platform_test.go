@@ -0,0 +1,128 @@
+package main
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestParsePlatform(t *testing.T) {
+	var cases = []struct {
+		spec    string
+		want    Platform
+		invalid bool
+	}{
+		{spec: `linux/amd64`, want: Platform{OS: `linux`, Arch: `amd64`}},
+		{spec: ` darwin/arm64 `, want: Platform{OS: `darwin`, Arch: `arm64`}},
+		{spec: `linux/amd64,netgo`, want: Platform{OS: `linux`, Arch: `amd64`, Tags: []string{`netgo`}}},
+		{spec: `linux/arm,cgo, osusergo,`, want: Platform{OS: `linux`, Arch: `arm`, Tags: []string{`cgo`, `osusergo`}}},
+		{spec: `linux`, invalid: true},
+		{spec: `linux/`, invalid: true},
+		{spec: `/amd64`, invalid: true},
+		{spec: ``, invalid: true},
+	}
+
+	for _, c := range cases {
+		if got, err := ParsePlatform(c.spec); c.invalid {
+			if err == nil {
+				t.Errorf("ParsePlatform(%q) = %v, want an error", c.spec, got)
+			}
+		} else if err != nil {
+			t.Errorf("ParsePlatform(%q): %v", c.spec, err)
+		} else if !reflect.DeepEqual(got, c.want) {
+			t.Errorf("ParsePlatform(%q) = %#v, want %#v", c.spec, got, c.want)
+		}
+	}
+}
+
+// Merged platforms are ordered as the package's platforms are, whatever order they're given in.
+func TestMergePlatforms(t *testing.T) {
+	var pkg = &Package{
+		platforms: []string{`linux/amd64`, `darwin/arm64`, `windows/amd64`},
+	}
+
+	var cases = []struct {
+		a    string
+		b    string
+		want string
+	}{
+		{``, ``, ``},
+		{`linux/amd64`, ``, `linux/amd64`},
+		{``, `windows/amd64`, `windows/amd64`},
+		{`windows/amd64`, `linux/amd64`, `linux/amd64 windows/amd64`},
+		{`darwin/arm64 linux/amd64`, `linux/amd64`, `linux/amd64 darwin/arm64`},
+		{`plan9/386`, `linux/amd64`, `linux/amd64`},
+	}
+
+	for _, c := range cases {
+		var got = pkg.mergePlatforms(strings.Fields(c.a), strings.Fields(c.b))
+
+		if strings.Join(got, ` `) != c.want {
+			t.Errorf("mergePlatforms(%q, %q) = %q, want %q", c.a, c.b, got, c.want)
+		}
+	}
+}
+
+// A type declared differently for each platform has the fields of every declaration, marked with the
+// platforms that declare them.
+func TestPlatformSpecificFields(t *testing.T) {
+	var mod = scanTestModule(t, map[string]string{
+		`conn.go`: `package conn
+
+func Dial() *Conn { return nil }
+`,
+		`conn_linux.go`: `package conn
+
+type Conn struct {
+	FD, Flags int
+	Name      string
+}
+
+type Poller interface {
+	Poll() error
+	Epoll() error
+}
+`,
+		`conn_windows.go`: `package conn
+
+type Conn struct {
+	Handle uintptr
+	FD     int
+	Name   string
+}
+
+type Poller interface {
+	Poll() error
+}
+`,
+	}, &ScanOptions{
+		NoCache:   true,
+		Platforms: []string{`linux/amd64`, `windows/amd64`, `darwin/arm64`},
+	})
+
+	var conn = mod.Package.Types[`Conn`]
+	var fields []string
+
+	for _, field := range conn.Fields {
+		fields = append(fields, field.Name+`=`+field.GroupNames+`:`+strings.Join(field.Platforms, `,`))
+	}
+
+	if got, want := strings.Join(conn.Platforms, ` `), `linux/amd64 windows/amd64`; got != want {
+		t.Errorf("Conn declared for %q, want %q", got, want)
+	}
+
+	// FD and Flags were declared together, but are declared for different platforms
+	if got, want := strings.Join(fields, ` `), `FD=FD: Flags=Flags:linux/amd64 Name=Name: Handle=Handle:windows/amd64`; got != want {
+		t.Errorf("Conn fields = %q, want %q", got, want)
+	}
+
+	var methods []string
+
+	for _, method := range mod.Package.Types[`Poller`].InterfaceMethods {
+		methods = append(methods, method.Name+`:`+strings.Join(method.Platforms, `,`))
+	}
+
+	if got, want := strings.Join(methods, ` `), `Poll: Epoll:linux/amd64`; got != want {
+		t.Errorf("Poller methods = %q, want %q", got, want)
+	}
+}
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"net/http"
 	"net/http/httptest"
+	"net/url"
 	"os"
 	"path/filepath"
 
@@ -80,7 +81,8 @@ func RenderHTML(module *Module, options *RenderOptions) error {
 			var found *Package
 
 			if err := module.Walk(func(p *Package) error {
-				if p.ImportPath == pkg || p.Name == pkg {
+				// package names aren't unique (e.g.: every command is "main"), but import paths are
+				if p.ImportPath == pkg {
 					found = p
 					return stop
 				} else {
@@ -125,7 +127,7 @@ func RenderHTML(module *Module, options *RenderOptions) error {
 
 			if err := renderRequestAndWriteFile(
 				options.TargetDir,
-				`/package.json?package=`+pkg.Name,
+				`/package.json?package=`+url.QueryEscape(pkg.ImportPath),
 				s,
 				filepath.Join(`pkg`, pkg.ImportPath+`.json`),
 			); err != nil {
@@ -134,7 +136,7 @@ func RenderHTML(module *Module, options *RenderOptions) error {
 
 			return renderRequestAndWriteFile(
 				options.TargetDir,
-				`/pkg?package=`+pkg.Name,
+				`/pkg?package=`+url.QueryEscape(pkg.ImportPath),
 				s,
 				filepath.Join(`pkg`, pkg.ImportPath),
 			)
@@ -0,0 +1,353 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"path"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/ghetzel/go-stockutil/fileutil"
+	"github.com/ghetzel/go-stockutil/log"
+	"golang.org/x/tools/go/vcs"
+)
+
+// Hosts whose repositories live at exactly "host/owner/repo", so any further path elements in an
+// import path are subdirectories of the repository.
+var WellKnownRepositoryHosts = []string{
+	`github.com`,
+	`gitlab.com`,
+	`bitbucket.org`,
+	`codeberg.org`,
+}
+
+// Returns the import path of the package in the given directory.  This is determined by the
+// module path declared in the nearest go.mod file, or failing that, by the directory's location
+// relative to a GOPATH "src" directory.
+func GetImportPathFromDir(dir string) (string, error) {
+	if abs, err := filepath.Abs(dir); err == nil {
+		dir = abs
+	} else {
+		return ``, err
+	}
+
+	if modDir, modPath, err := locateModule(dir); err == nil {
+		if rel, err := filepath.Rel(modDir, dir); err == nil {
+			return path.Join(modPath, filepath.ToSlash(rel)), nil
+		} else {
+			return ``, err
+		}
+	} else if !os.IsNotExist(err) {
+		return ``, err
+	}
+
+	if srcRoot := locateSourceRoot(dir); srcRoot != `` {
+		if rel, err := filepath.Rel(srcRoot, dir); err == nil && rel != `.` {
+			return filepath.ToSlash(rel), nil
+		}
+	}
+
+	return ``, fmt.Errorf("%s is not inside a Go module or GOPATH", dir)
+}
+
+// Returns the import path prefix of the packages allowed to import the given package, if it is
+// internal.  Per the go command's rules, a package beneath an "internal" path element may only be
+// imported by packages rooted at the element's parent, e.g.: "a/b/internal/c" only from "a/b/...".
+func internalImportRoot(importPath string) (string, bool) {
+	var parts = strings.Split(importPath, `/`)
+
+	// the innermost internal element is the most restrictive
+	for i := len(parts) - 1; i >= 0; i-- {
+		if parts[i] == `internal` {
+			return strings.Join(parts[:i], `/`), true
+		}
+	}
+
+	return ``, false
+}
+
+// Walks up from the given directory to the nearest go.mod file, returning the directory it is in and
+// the module path it declares.  If no go.mod file is found, an error satisfying os.IsNotExist is
+// returned.
+func locateModule(dir string) (string, string, error) {
+	for {
+		if gomod := filepath.Join(dir, `go.mod`); fileutil.IsNonemptyFile(gomod) {
+			if modPath, err := readModulePath(gomod); err == nil {
+				return dir, modPath, nil
+			} else {
+				return ``, ``, fmt.Errorf("%s: %v", gomod, err)
+			}
+		}
+
+		if parent := filepath.Dir(dir); parent != dir {
+			dir = parent
+		} else {
+			return ``, ``, os.ErrNotExist
+		}
+	}
+}
+
+// Reads the module path from the "module" directive of the given go.mod file.
+func readModulePath(gomod string) (string, error) {
+	if lines, err := fileutil.ReadAllLines(gomod); err == nil {
+		for _, line := range lines {
+			if i := strings.Index(line, `//`); i >= 0 {
+				line = line[:i]
+			}
+
+			if fields := strings.Fields(line); len(fields) == 2 && fields[0] == `module` {
+				if modPath, err := strconv.Unquote(fields[1]); err == nil {
+					return modPath, nil
+				} else {
+					return fields[1], nil
+				}
+			}
+		}
+
+		return ``, fmt.Errorf("no module directive")
+	} else {
+		return ``, err
+	}
+}
+
+// Walks up from the given directory to the nearest GOPATH-style "src" directory.
+func locateSourceRoot(dir string) string {
+	switch filepath.Base(dir) {
+	case `src`:
+		return dir
+	default:
+		if parent := filepath.Dir(dir); parent != dir {
+			return locateSourceRoot(parent)
+		} else {
+			return ``
+		}
+	}
+}
+
+// Determines the URL of the repository containing the given directory.  A URL set in the options
+// takes precedence, followed by the remotes configured in a local Git checkout, and otherwise the URL
+// is inferred from the module path.  Only if asked to (and not running offline) is the module path
+// resolved over the network instead, as "go get" would.
+func repositoryURL(dir string, importPath string, options *ScanOptions) string {
+	if options.RepositoryURL != `` {
+		return options.RepositoryURL
+	}
+
+	if remote := gitRemoteURL(dir); remote != `` {
+		return remote
+	}
+
+	// every package in a module shares the module's repository
+	if abs, err := filepath.Abs(dir); err == nil {
+		if _, modPath, err := locateModule(abs); err == nil {
+			importPath = modPath
+		}
+	}
+
+	if options.ResolveRepository && !options.Offline && importPath != `` {
+		return options.repositories.resolve(importPath)
+	}
+
+	return importPathToURL(importPath)
+}
+
+// Repository URLs resolved over the network, keyed by the import path they were resolved for, so that
+// each module is only looked up once however many packages it contains.
+type repositoryCache struct {
+	lock  sync.Mutex
+	roots map[string]*repositoryRoot
+}
+
+type repositoryRoot struct {
+	once sync.Once
+	url  string
+}
+
+func newRepositoryCache() *repositoryCache {
+	return &repositoryCache{
+		roots: make(map[string]*repositoryRoot),
+	}
+}
+
+// Resolves the repository URL for the given import path (as "go get" would), falling back to
+// inferring it from the import path itself.  Packages are scanned concurrently, so concurrent
+// lookups of the same path wait for the first to finish rather than repeating it.
+func (self *repositoryCache) resolve(importPath string) string {
+	var root *repositoryRoot
+
+	if self == nil {
+		root = new(repositoryRoot)
+	} else {
+		self.lock.Lock()
+
+		if r, ok := self.roots[importPath]; ok {
+			root = r
+		} else {
+			root = new(repositoryRoot)
+			self.roots[importPath] = root
+		}
+
+		self.lock.Unlock()
+	}
+
+	root.once.Do(func() {
+		if rroot, err := vcs.RepoRootForImportPath(importPath, false); err == nil {
+			root.url = rroot.Repo
+		} else {
+			log.Warningf("cannot resolve repository for %s: %v", importPath, err)
+			root.url = importPathToURL(importPath)
+		}
+	})
+
+	return root.url
+}
+
+// Returns the URL of the "origin" remote (or the first remote, if there is no origin) in the Git
+// configuration of the checkout containing the given directory.
+func gitRemoteURL(dir string) string {
+	var config = locateGitConfig(dir)
+
+	if config == `` {
+		return ``
+	}
+
+	if lines, err := fileutil.ReadAllLines(config); err == nil {
+		var section string
+		var remotes = make(map[string]string)
+		var first string
+
+		for _, line := range lines {
+			line = strings.TrimSpace(line)
+
+			if strings.HasPrefix(line, `[`) && strings.HasSuffix(line, `]`) {
+				section = strings.TrimSuffix(strings.TrimPrefix(line, `[`), `]`)
+			} else if strings.HasPrefix(section, `remote `) {
+				if key, value := splitGitConfigLine(line); key == `url` {
+					var name = strings.Trim(strings.TrimPrefix(section, `remote `), `"`)
+
+					if first == `` {
+						first = name
+					}
+
+					remotes[name] = value
+				}
+			}
+		}
+
+		if remote, ok := remotes[`origin`]; ok {
+			return normalizeRemoteURL(remote)
+		} else if first != `` {
+			return normalizeRemoteURL(remotes[first])
+		}
+	} else {
+		log.Warningf("cannot read %s: %v", config, err)
+	}
+
+	return ``
+}
+
+// Walks up from the given directory to the nearest Git checkout, returning the path to its config
+// file.  Worktrees and submodules (where .git is a file pointing elsewhere) are followed.
+func locateGitConfig(dir string) string {
+	if abs, err := filepath.Abs(dir); err == nil {
+		dir = abs
+	} else {
+		return ``
+	}
+
+	for {
+		var dotgit = filepath.Join(dir, `.git`)
+
+		if fileutil.DirExists(dotgit) {
+			return filepath.Join(dotgit, `config`)
+		} else if fileutil.IsNonemptyFile(dotgit) {
+			if data, err := os.ReadFile(dotgit); err == nil {
+				var gitdir = strings.TrimSpace(strings.TrimPrefix(string(data), `gitdir:`))
+
+				if !filepath.IsAbs(gitdir) {
+					gitdir = filepath.Join(dir, gitdir)
+				}
+
+				// worktrees keep their config in the main repository
+				if common, err := os.ReadFile(filepath.Join(gitdir, `commondir`)); err == nil {
+					gitdir = filepath.Join(gitdir, strings.TrimSpace(string(common)))
+				}
+
+				return filepath.Join(gitdir, `config`)
+			}
+		}
+
+		if parent := filepath.Dir(dir); parent != dir {
+			dir = parent
+		} else {
+			return ``
+		}
+	}
+}
+
+func splitGitConfigLine(line string) (string, string) {
+	if i := strings.Index(line, `=`); i > 0 {
+		return strings.TrimSpace(line[:i]), strings.Trim(strings.TrimSpace(line[i+1:]), `"`)
+	}
+
+	return ``, ``
+}
+
+// Converts a Git remote (e.g.: "git@github.com:owner/repo.git") into a browsable HTTPS URL.
+func normalizeRemoteURL(remote string) string {
+	remote = strings.TrimSuffix(remote, `.git`)
+
+	for _, scheme := range []string{`ssh://`, `git://`, `git+ssh://`, `http://`, `https://`} {
+		if strings.HasPrefix(remote, scheme) {
+			remote = strings.TrimPrefix(remote, scheme)
+
+			// strip any userinfo and port
+			if i := strings.Index(remote, `@`); i >= 0 && i < strings.Index(remote+`/`, `/`) {
+				remote = remote[i+1:]
+			}
+
+			if host, rest, ok := strings.Cut(remote, `/`); ok {
+				host, _, _ = strings.Cut(host, `:`)
+				remote = host + `/` + rest
+			}
+
+			return `https://` + remote
+		}
+	}
+
+	// scp-like syntax: [user@]host:path
+	if host, rest, ok := strings.Cut(remote, `:`); ok && !strings.Contains(host, `/`) {
+		if i := strings.Index(host, `@`); i >= 0 {
+			host = host[i+1:]
+		}
+
+		return `https://` + host + `/` + strings.TrimPrefix(rest, `/`)
+	}
+
+	// a local path; there's nothing to link to
+	return ``
+}
+
+// Infers a repository URL from an import path without consulting the network.
+func importPathToURL(importPath string) string {
+	if importPath == `` {
+		return ``
+	}
+
+	var parts = strings.Split(importPath, `/`)
+
+	for _, host := range WellKnownRepositoryHosts {
+		if parts[0] == host && len(parts) >= 3 {
+			return `https://` + strings.Join(parts[:3], `/`)
+		}
+	}
+
+	// import paths whose first element isn't a hostname can't be resolved to anything
+	if !strings.Contains(parts[0], `.`) {
+		return ``
+	}
+
+	return `https://` + importPath
+}
@@ -736,6 +736,45 @@ pH4cDg9HLOWlvPU1xxI/5FSW1gu8jRqgUbf3zd7s0zd785G285gqs8IQm/8bAAD//y+jxWq1kAAA
 `,
 	},
 
+	"/-/diagnostics.html": {
+		name:    "diagnostics.html",
+		local:   "assets/-/diagnostics.html",
+		size:    1364,
+		modtime: 1500000000,
+		compressed: `
+H4sIAAAAAAAC/5xTT2/UPhA9x59ilFa/068bQS+oeH0BcWoRgvIBvPbs2tSxgz37p7Ly3ZGTLNmWUgp7
+WGnieW/ezJvhqygY13YHysmUlrVyKOPaHmqwelkfLroYvnm5qwWruAQTcb2sc4bzxcp6bf0mLW6C3jpc
+3CBJLUkuvn6+hr6vBYPpxxPF4DfieditJYfQ97yZ0gc8b6RgvNF2Jxjj5nJQpa3c+JDIqlR0vZ/DQeTU
+SYexlc76u3qSffYA99/Zqzev30785lIwljPsLRk4PyGEq+UTok8T+p7xbhR7azAhdDGsHLYJ9hgR0Kuw
+9YQRNeyNdQhJSe+t3wAZhHYkBPhgHSaQXkMn1Z3cYAIykkCFrdPgA8EKhyIRpYYQoZMxoR6LpDvbdaj/
+H/Ay4pCvg9q26Ak1BA9kbIJkCReMN10ZJsmVw+OwxmD4v1Ch7aSiKTJhh7EWjJNBqcsaUBSsqjiZn2A8
+0IXDNdXiC+4wWrrnDZnfZ30aW3w+6TooSTb457NuMKWZijdFHG8mrZxWQd8LVuUMUfoNnno7WPvIydLc
+sUjOYNeA3w+nWYtjg1BjjCHW0Pe6MMecAV0qG7yXsfhbPng9nkJRr59Qn/PT5OUMSL8YN83zjzBWVdXY
+1im6rF6BqqDxMfP09ivo2vrycJXzSz6/C27b+pFoHApvjuXGuGj+m5Yn3+eWR+urmZA3k/u8GTZZsNki
+xrsHJdotoa7Fx/BP1zsc1Fz4xwDh+hZLVAUAAA==
+`,
+	},
+
+	"/-/interfaces.html": {
+		name:    "interfaces.html",
+		local:   "assets/-/interfaces.html",
+		size:    1425,
+		modtime: 1500000000,
+		compressed: `
+H4sIAAAAAAAC/5RUQW/bPAw9W7+CcIMP34bGxtbLsCo69DZgLYqiu1ex6EiLLRkymzYw9N8HKW7sbG2H
+5RBYfiTf4xNpvvaCcWV2UDWy71d51aD0tXnOwahV/rzsvPtp5S4XLOMStMd6lQ8DLIq1scrYTV9cO/XY
+YHGNJJUkWfy4+w4h5ILB+OM9eWc34v20e0MNQgi8HMNTPi+lYLxUZicY4/oiqTKW0Neywj7K+nY8JYlj
+Hx36VjbGbvNR9Nk867+zT18+X47F9YVgbBjgyZCGxVQOvq5eETzDQ2C8O+i81wgTASisGulRgbFA2vTQ
+puRzkFYBaQTad9gfUBxBIC0JTNs12KKliLQF42UXOye5bvClt8Mh/S8r13ayovGk3Q59LhgnjVLFOyMv
+WJZx0sdkfKZlgzXl4tgKL0m/E/YiCRVc7cdYXsbKvByJOK2d2guWDQN4aTc4MzL5eGrbpEu9Qsiy7GTY
+nIdF0ckNFt456iRpeCgfIIRuuymHYVa8mD21nfN0G4NDKDS1zdlboTeyxTSyvHIKxVtht7Layk2MLP5S
+iZepUJqvLFql3m92Ms2cw+IO61PLitkNXO0hhGEAU8PCQAjnMAyAVkXWf7HsDuu3LIrQn5aY+oDcujTn
+EMLHI/NL1m8OzQrNHIlZTZ9e9p20J5a0j4QqF9ZZ5GVExZFjMvIwetmE8HKcPl6mNRBs4mC8e43hxs3X
+9Qk9Qu0erbqcFlCScbYH6RGcbfagkNC3xqKCJ40nm2t66CtpExQ/InG7wdja+TZVgf8PNi6XEak0VtvR
+kA9FWvCpl18DAE5nAcuRBQAA
+`,
+	},
+
 	"/-/jquery-2.2.4.min.js": {
 		name:    "jquery-2.2.4.min.js",
 		local:   "assets/-/jquery-2.2.4.min.js",
@@ -1264,189 +1303,237 @@ Cw0a36xxfT5uL9Q2aige3/7d6hiniX4HAAD///a0WAM1AgAA
 	"/-/site.css": {
 		name:    "site.css",
 		local:   "assets/-/site.css",
-		size:    3067,
+		size:    3258,
 		modtime: 1500000000,
 		compressed: `
-H4sIAAAAAAAC/5RW3W6zOBM+DlfhT9Un7Up1RBJCWiJFeysDHoLfGBsZ06S76r2vbAMxhObtHiUez+8z
-z4ypTC3IP9Eqh+Jy1qqTjBZKKJ2Ra8UNtrW64DH6iqJcsc8nik6nSqxGDfrMJTWqycg2bm7ual0oaYBL
-1F7lRq+cmSojh+1br/Jyo41WvyR8OBUuaYX8XJnBy+A4V8aoepQ+JvSCiMdo1QBjXJ4z8u71lGaoqQbG
-uzYju3vUUinj8+pNfO6bxNkNsiHsZh8m4zT3YQBv29xIqwRn5KUoim+y3OChTDw6FT9XwhaLbBnkl5KV
-5TuOQF3OXJbqAe4fZDKpcRlZX+JXFBWK4XI+XFaouRmDZUQqaVEf8t1ut2EPYuev0c7dVEd9oC6FumYE
-OqOO0coRirYNFJiRRluvV6UZzTXCxQbSNYhBeNXQ3GUWy7KTBcNCnPpoc3fOZObT/VAQM7debAVj+utC
-1WENcZymsa9ubaM6GqmWG65kRjQKMPwD7/cnmGpA3irR2QFauY74hmhPfH9gvG0EfI4QD4g/kmzsO3UO
-RrInIS18l6nAcq4wUMNezWV9Rskwz76WrLLde7oXVgZvhjIslAZfsi9jcOJdeFjGSnOhiovXkfCRgw8x
-neD4kbi+DxD2Z3fYI+ShK8qwhE4YMpxzDZIFNveFNrdYHs0hxNiY+PjEl91va4dJ3Rlkr9OL/r+9D6tw
-/X1SgoSPk+An+FEVodEaCsvPE7z+TM1366fKpSq69hvU0u3bJmfH/5Yv+UkC5HngZLtPDyX5H68bpQ1I
-E2wtxtjk4nes+V02XmnM5lmYHGT/OoLgZ0m5wbrNSIHSoF5+QcqyzDEON0Qp0A7tr641vPyk9tFFaTLi
-th/N0VwR5bdPafjmJnE4/hl5a25kkw7T75OlNbYtnDF4hvo18R7PNC0llKSTrwA3BK7YjDi7BYuHBS6V
-X99fUfRXjYwD+SP8mkjfmtuf1ijAc2VRoYxrLPwCKpToagvDVzQqhrVMO+CsWwPaEuXhqfQwzcqPZ76X
-ql/1KW/i+P9O3dbegEQxsOjUHysE24PJVtvtFilhNMi2AY09q2DdoH0ZubxM9uu4gmHdtdgu3I2h0jQ9
-RqtSSUNb/jdmJF6/Ye2/9zb9/r9HeY2q7ZJwtyRMloT7JWG6JByj2xLCwP15Nzsns/N+dk7D8wQSLgXv
-AXtOulLp2rVZK+Gpd8fNT0/f6PZTqqblLoxqoODm0yF78DNgp85SBgQdvvPmb2Notk+Gd9YRwGaRka5p
-UBfQ4rx9h73r378BAAD//wo57Wz7CwAA
+H4sIAAAAAAAC/5RW7Y6rOA/+Xa4ir0avtCsNFf3uoVK1t2KIKTkNSZSEaWdXc++rJMAEyunp/oI4/vZj
+O7VtOPknWRRQXi9atoKmpeRS5+RWM4umkVc8JV9JUkj6+YTR89Rbx9GAvjCRWqlyss7U3V8tSyksMIE6
+sNzTG6O2zslhfexY3u6p0vKngA/PwkRaI7vUttfSKy6ktbIZqI8OvSHiKVkooJSJS05+BD6pKepUA2Wt
+ycnm22olpQ1+dSLB99XWy/W03uxqFzvjOXexgSCr7sRIzih5K8vyF16u8FBtQ3Zqdqm5CxbpfJLfKlpV
+P3BI1PXCRCUf0v2CJ6MY5zMbQvxKklJSnPeHiRo1s4OxnAgpXNZ7f9frdVyDzOtT2qsb88gP1BWXt5xA
+a+UpWXhApUZBiTlR2mm9SU3TQiNcnSHdAO+JNw3qm+ZyWbWipFjyc2dtqs6LTHT6Twp8ojaQHWFwf1nK
+Jo4hy/b7LES3dFbdnZKGWSZFTjRysOwj1G3ZCrwrqbsiSwUls585yZaHXQ8DimnENZGgzCgOn32ue5Nn
+GBuFwkjeup5c+CKHGuvQS+Ew0TQU8RG3A5RSr2Don22MtACclGM1ZejR5q6mtM6jrbrHseS1A8TTUbOw
+eLcpxVJqCCGPExJUhLQMkRZcltfAI+CjgGBiPBSyx14IpYW45JvDDqGIVaUUK2i5Jf250CBoJPM9I6cS
+893emxgKk52e6HIjc+lz0rQW6fv4ovt393EUvr5PQhDwcebsDC9FEQstoXSQP8P7a2yhWq8yV7JszS+y
+tl8fVwU9/Td/ySsOkOeGt+vd/lCR/7HGNSsIGw1CSuno4neo+Z03gWnw5pmZAkS3cIGzi0iZxcbkpERh
+Uc8vpaqqCsziCVFxdE37szWWVZ+p2+MobE78QE0LtDdE8cvtHK/xbRa3f06O6k5We3WPnU0bNAYuGG22
+bkz8yCacDhJSpKOHhW8CH2xOvNyMxMNOEDJshK8k+atByoD8ET9Q9kd1/9MJRflcuKyklGkswwAqJW8b
+l4avJFnMxDKugJc2FrQDysP2DWmahJ9NdM9Fv+hcXmXZ/z27i12BQN6j6NwdawRXg9FU22xmIWE1CKNA
+Y4cqWCp0y5aJ6/xOgmVr0MzcDab2+/0pWVRS2NSwv9FtwCM2XrhedfP/28p7Uq/niJs54naOuJsj7ueI
+g3UXQmy4O28m5+3kvJuc9/F5lBImOBP4AugqqRtfZi15gN533kL3dIU2n0Iqw8zkbXE8hB5wXecgAzzt
+n47T3RiL7bb9nvUAcF7kpFUKdQkGp+U77Lr6LfEOjeJx/45xHfOc3WuKc1AG38kMtUPo+A3RP07/HQBV
+xNXKugwAAA==
 `,
 	},
 
 	"/-/site.js": {
 		name:    "site.js",
 		local:   "assets/-/site.js",
-		size:    12590,
+		size:    12723,
 		modtime: 1500000000,
 		compressed: `
-H4sIAAAAAAAC/7Q6a3fbNpbf9StuWDUkYwqSkjSnlaxksqmzkzNNZrdxz+5Z252BSEhETRIsAErWJP7v
-e/AgCVKUk+nO5kNMAhf3/QQ1ffJkBE/gkuYEbxlQARh++8+K8AOUWbWlBcgUS8jxLRFAJRAsDiAZiKos
-GZeAK8lyLGmMs+ygMFVlgiUttrCp/vGPA0iaEyFxXgoICNoi8J5DTotKEgF4yzxgHDy8ZpWEOST4oBdD
-NALN1p8KnBONA2+ZXtgRLigrYI7maKZXOPm9opyImu3dHD1Fz870Hq5kyjj8fMAFvI//nWCuefxTRmNS
-CALv313CT/Z5AqmU5WI63e/3iJWkEKziMUGMb6cWXkxzKif2BZVpadl8yzhUAm8J4CIBcofzMiMigh0V
-VC4UhEVtJUEHzEsUs3xqEbxh5YHTbSohiEN4Opt9P3k6mz+LOpxDwNXb5ArLmwnk8VYvXj1JmHxyA4xv
-wxE8mY5GwaYqYqmUFGxwLBk/hPBpBEA3EMhDSdgGErKhBYHVagV+De3D48d2A+E8MWcAplN4/f5HBD+T
-LRWScMACcAG4YMUhZ5WAnCVVRpAGNseDK/+335Ut/JsIahaWI4B7IJkgLeJ/42wvCIdtxtY4E3rZwgfG
-mubY6N6RaWw4GyOrTFhBvRk03lZzr2WuF4EWQuIiVhr4EUtSAwFwIiteAC3+i/FEOGiWGsDy7Siwxal0
-6AnJabH1TiJsmEUl5oI4BL6WQlHla8JPUyjIXst0EvUXOUuwJOp5AIOygXrcYQ5jCatW+cuRNgW5k6RI
-WmSRJSeIVLlALBzyG05E+p5mGRULeDGbzWaR3cNZxvZvK1lxsoANzgSpdzIW44xcUpn1d4zmHQIAJScb
-evd6yxZQVFkW9TbecpZ/YPv+pqg29SlP5aD+TnPM23CWQ8H2LgiJWZGIBXgZEUKlzAKwzXMOmFlY1Anv
-JIRC9G1SvzjbKat4e7zQ771t0ex/m5gFByDBB7Wt/nZXLUX15HLDCpm67Kr3/n7NrH52Ng8EO7zq196u
-Pakfna0948lHUmKOJVMYwNkzYSAWcHVj1+6NixoQ69WLNiMk1IS8cbg2fLQrZ7ACmVKBaj9F1p2WDpTx
-GuX0GWpcywUw/mEAGi+qAXREd0g4Xh46XqsAu8zCOcxcCBhgxXrl0gE6ZucI6L7RnSuG8WFYwXssU4TX
-4kh5U5jPZjNX9rqUr5rjU3jRgdAuCKsGsr+vXA5WFmwKT5+7m9o1YGWApvDsxXfLmuWmIIhqLSSVlSSB
-sd5f+Vu7F1l/cbWoRdVwOo9RUQMfnQ7hFfTXAoMwgr5qFkegyw7JHc4qAisIxhmyTqzqbft2Zf7ehPD5
-s2W7xWCTtiGBOCkzHJNg+m0ypZFBHS6HbKpCyTXOOTz/TpF1dKa8xOxGxvCcVUUS2LVQsdNPc3AOP8yO
-0RgLRzDvnKntPkza7nZI27XwBJoh0sp7+oSNR53D0+fD8F2ieiUcRPD86TGCBB/69LSPnsOz2SB0l5pa
-CIdOD+pIZdZT1F6cOnBMUAXQrEvVxNc5zNEAGrXZJ3sM0aWjV1Tf0EksNpebnNRJ78rZPc9NlkcQqv2p
-CtNZqq60g88Db9mkMxslYyQ5zYMrkyEjEwSRzYs36DdGi6DB0fQ4poDo9swpH1Sw71/M5t26IUwHpIjU
-+7UEOtjaAL1G18nZNPK8cKn6XU5ytiOQ65xhYmn43GQaeVMvPFo4QeZyGnnggv+PWvjl8s3JE8HV9dn1
-5OY6uU7C68WrQD+oQ+P5+KnldjJ7vpjNYPJSPc1mXSU3/aboKrDuIh0dkozkXQVarSk1SkSFGj4t1CsY
-myeEpeSBV6PzVIbtbknVDbYC1saXtsXuWqbuDzStk7xNp3aI9AX8nYog/DskjIjCl1Bm+AB7kmWwpzKF
-P1++/+k7oAW8u+jRtzxuiQxmIZJ4+wHnBEn2E9sT/gYLEoSmo9diaUXXZ6gIzKrbd5tYmk4bnoWZyGNc
-wJqAmrtJAjuKNZqw7sADH5upLjSnaUGlmu4TssFVJmGfkgIKBgZK7WzpjhR9Upg3JLTcMSskuZPANoBB
-0GKbEVDMk0La+aA9u6pnsIJKR+Wh6wl2HPgbyXRMlZzdHQK7GOnezDFwDRqEbo8wFsaP6gark0yUyzsD
-B7zstlSCyHeFJHyHs6DFH0H/nFNdHW/SVx2uNyndN+KNdd8XqrkKB741ix/BJydGGm/VJ7WxO8IiXJbZ
-IXD0YGcxPXVtiqEZGNvWh5XaDLW42jYFrGqLv2otdWWWbqAVRSBltaWdoYNHm6KRS6ac7XX8X3DOeOD9
-UtwWbF+0HZm+tPG9s5rUmefDhvH6Hsd1b+1uBMcpsB3hwNa/kVgKSAk3dynK90CmBDj5vSJCkqQhMzLM
-UIHU+eDYvzYFUse19lp1WNr2r41aBbJsNNsIYq0QuDpU5oSVasVLzMmPyriOeZTTPaLiA/4QKMBmqA5b
-r6v9QsVRUI/hPWBXQX0WOxy6bNg4rCnZVxUczZbDpF0y7uk1pmn5HAY48t/m2qAhf98JT50umnpZI9XC
-h2EnVtsQRs6874ZrfdotAFGX0SbQwoYznXwVvo+6fXbIurcu5E6ijBRbmaokobqhR0G3OmnhHj8e5CIM
-v8ynItFPJK6FT1jk2OiO0zTqactfs2snFAM2gKYL0MXT3ifpUqZ1EMJEm75daJGqokHvdJS/u3gBoopv
-8ZaMABIWV1qqmBMsyYWRMfDwes2NbKcg6kp4r8ioylzlJeQswdlo7EQ7fBo1sYmzbNm87Kig64y0Cyob
-7dQMNpm3ixkW8i3NJFHdpO+3G+M1Sw46ePxv7iaK+ESt+KEDklEhuyBqpQOyqXE7QGatA6bl6kD5dROt
-9k2lcbO8weE6XUcSs73s3C5Y+V+u+tcKUOvqyoDcIIJMq/omw0KYVmJH/HDZOXSkT/dqocEJq/aupq75
-sNL142eyvbgrrShub4qenL36dfVoMf50H4Sfr6+ub66n19c34XQbgXd9PZ57YQTelnoORzjL0IbxC7cW
-QECTvqg0QQQlRCq4nkBOuqKJTlHdfaXEY73X/7onG3E4idqQC1Q5rmPMP1+/9OEMBJyBfz5dv/SXTu7s
-kjXIG+xD9NvoPdq6H51+0/pIZZ4ZGmfgg68Zopo5mqBbWiSGRfqy7wPWyKisRKqU7TiC86xjCYmYsyy7
-ZGUwC7ueWWNp829fwMbXZssvOC5OkpNe20quQ1d1V+baOsdlzUTkDH8JKSTdUG3v5uK8WURE2aup1U2w
-0iLmr2t+24tIkkncv/DrCb4aCM2eSY/i6+tj1urwbAWal8HkcP71qn/IqE5hbbNOV9iTZHo6mcD8Ybr6
-/Wp2Y5f+TPR3tAk063FGSSHN+jGPn45SwNjhotXuQKpQ+ZqgkglqShGSrDwGW8MKVFyNCWKVJNwwEuiv
-GOFxgpFwPuAFJwV33kM4A9nD6BhirWxgzhzz8UfprWtFD+Fcnkg7D/nwqfC18WUKJWJF4IuU7dFaIL3i
-O2HbD7JHOMuOvC3LuoWptpZKdnp09WN/AX7M9CdD6Ufg79TCDnOK1xlRCxu10Hw+jcCXakEeSr2b6F1K
-skS95eotJzJlid9NwOPAf3JFkxs/7A8yAzZRLBLdJ3Rm4y4ATWAFxHSfPk38EzVl+uvVr3+7ufp1cvNk
-PEWSCKky+KnSouqrzvLD23UFXABNopMQWQ3SvRA5fUCZY2GMckVQnDGh2PSvVI88UctKbUbQZskPb04j
-VENL4J/j6Us1iqecbBbgf2MqXQR+rHxPmUrVh8mWs6qcUElyPwKJ17RIyN0C/Mncvw8HSQzV71717XdR
-WYYE49JpWnAE6yEz6HSKtA7hJazNk1Oa5sserf6h84FDk8FTdnN2knU7v4RNKBZfjEXTxAa+64+2P0Y7
-nKkNtGFxJYJmSNfYU5qQLyKvEa2zivfOxxmNb1UMomOjDuMySUb/b8j7zrXByCWn8ae42BK4JYeqPIVS
-3740o4AjdK8TsjuS/VKWzXXho5XT3Hf3+m5idWy71BPmuiWHhO0Ll9fOmC32VMZpQNA+pXGnUMdYEHj2
-/ULNe1XZrV1N1xNM5j0fJ6jkZEcK+aO5fQzCJXQA1pzg22WXzPOZJqM4PUXoX0Jn/kzTIYUk/KgaPzAz
-DdYv22/Et8HJ6tfwcWSc5Wik/xsabxUzHitSLFLjbx7QAva0SNi+72gp3aaZKsQk+agvVtsvLGCPIBeV
-26YejUsbCLr4BluFPkynF/WcTe9BvRxxbrnNWIwVe0gx3YxWMA0Wn6/R5+urz9c3nyNnPISBxqqH+/Hj
-/hLS6f2vm8Czv+CaeKFqySfz8OtkbnqXrxP4/kGjBG3jo51iOlUZZs0wT0CkjMu4kqLvKs5vFnZvUjVm
-V1kW6ddLmusmPoL6rkF3aXU+C3ybX3WKcHJByvaLjns05yWv1ATk/PyEJgkpTkAbaq6vG7r19U+TmkpO
-hDiZm5R0McvXTLc4kubko/6R1KQV8nzV+ZkCdMRvvkwap9DcDY9cRj4nSEf95p8gifmWyPorz6jbx69W
-4L/78B+/XPruF1TdKOm9jxc/Xbw5tXl58d+Xr3++eO3DP8udIt5wpr/XFPIioVJ1rfr68tSeIquw+n+M
-Yk4k/gs5wOfPQFAsefYXcvgjmo2V35qrWrThLH+TYv6GJaSpRz0Tam/oEzIlDAIbCGcQHw2cOvl72623
-GAhtP5V5FpkLP4QLmquq+qmZfRYwu4/8DW4v+waktPE1QHP9x2i6wZKaSSv8v7BBB9jQN/GB/015u53o
-fOiHD1zL/FPMey1SL0RssxFEmqH5ASEeFuS4gljRyBdEq3+j+6+Wrsb7/yfg/VDc1O4+0K15054qzBWz
-IJjH6cT8TLfXdz/MlsH6ahhrXZj80LbPX48SvE0PZ22w+kL8C6bqgA5Sf1jXg5ptKmmcDtUTpwSNTia5
-e/0Vw9TwnIp4qGqPA1+UuPmg67cf8p2PdoPdkP5Jjv6wNYvgB92x+N/UHYzfr536bkDtT9TM+wWEP7iT
-WkATp8XxaeF3uzw/ZlmGS0H8Jjv5uJKsf4PTrfie7pa9CDxalJU0zfNEkIzE0jvVBIybAhciAxocDWiB
-b8PVRKkoD05XYw4vwEfbJGETQROyxtxvWxkTvAuYzzoW/N8AAAD///xpdzouMQAA
+H4sIAAAAAAAC/7Q77XbbNpb/9RQ3rBqSMUXJSZrTSlYy2dTZyZkms9u4Z/es7c5AJCSipggOAFrWJH73
+PRcASZCinLQ72x+xiHtxv78AstMnT0bwBC7YlpINByaBwG//WVGxhzKvNqwAlREFW3JDJTAFlMg9KA6y
+KksuFJBK8S1RLCF5vkdKVZkSxYoNrKt//nMPim2pVGRbSghovInBew5bVlSKSiAb7gEX4JEVrxScQkr2
+ejGMR6DF+lNBtlTTIBuuF26pkIwXcBqfxjO9Iug/KiaorMW+PY2fxs9ONIxUKuMCft6TAt4n/06J0DL+
+KWcJLSSF9+8u4Cf7ewKZUuV8Ot3tdjEvaSF5JRIac7GZWnw53TI1sQ9xmZVWzLdcQCXJhgIpUqB3ZFvm
+VEZwyyRTc8SwpK0m8Z6IMk74dmoJvOHlXrBNpiBIQng6m30/eTo7fRZ1JIdA4NPkkqjrCWyTjV68fJJy
+9eQauNiEI3gyHY2CdVUkCo0UrEmiuNiH8GkEwNYQqH1J+RpSumYFheVyCX6N7cPjxxYQk21q9gBMp/D6
+/Y8x/Ew3TCoqgEggBZCCF/stryRseVrlNNbIZntw6f/2D/SFfx1BLcJiBHAPNJe0Jfxvgu8kFbDJ+Yrk
+Ui9b/MB402wb3Ts6jY1k49gaE5ZQA4Mm2mrptc71IrBCKlIkaIEfiaI1EoCgqhIFsOK/uEilQ2ahEazc
+jgFbmmhDTyrBio13lGAjbFwSIanD4Gs5FNV2RcVxDgXdaZ2Okv6iZClRFH8PUEAf4M9bImCsYNkafzHS
+rqB3ihZpSyyy7CRVWAvk3GG/FlRm71meMzmHF7PZbBZZGMlzvntbqUrQOaxJLmkNyXlCcnrBVN6HGMs7
+DABKQdfs7vWGz6Go8jzqAd4Kvv3Ad32grNb1Lg9rUB/SbPPWgm+h4DsXhSa8SOUcvJxKiSWzAGLrnINm
+FuZ1wTuKgYS+TesHB5zxSrTbC/3cA8sG/m1qFhyElOwRjH+7q5Yj/nKl4YXKXHHxuQ+vhdW/HeCeEkdW
+/diD2p36pwPacZF+pCURRHGkAA7MpIGcw+W1Xbs3IWpQbFTP24qQMpPyJuDa9NGhnMMSVMZkXMdpbMNp
+4WCZqMGgz+MmtFwEEx8GoYmiGkFndIeFE+WhE7WI2BUWzmDmYsCAKDYqFw7SoTgHSPeN7Vw1TAzDEt4T
+lcVkJQ+MN4XT2Wzm6l638mWzfQovOhg6BGHZYPbhGHKwtGhTePrcBerQgKVBmsKzF98tapFrD4OsVlIx
+VSkaGO/9Vby1sMjGi2tFrarG03WMyRr5YHcIr6C/FhiCEfRNMz9AXXRY3pK8orCEYJzHNoix37ZPl+bv
+dQifP1uxWwq2aBsWsaBlThIaTL9NpywypMPFkE8xlVznnMHz75CtYzOMEgONjOMFr4o0sGshitMvc3AG
+P8wOyRgPR3Da2VP7fZi1hXZY27XwCJkh1hg9fcYmos7g6fNh/C5TvRIOEnj+9JBASvZ9fjpGz+DZbBC7
+yw0XwqHdgzbCynqM24tjGw4ZYgLNulxNfp3BaTxABoF9tocYXT56BeeGTmGxtdzUpE55x2D3PLdYHmDg
++FMVZrLEqbRDzwNv0ZQzmyXjWAm2DS5NhYxMEkS2Ll7Hv3FWBA2NZsYxDUSPZ077YJJ//2J22u0bEpY1
+kxpea4Ag2SboVXyVnkwjzwsXOO8KuuW3FLa6ZphcGt43mUbe1AsPFo6wuZhGHrjo/4MLv1y8ObojuLw6
+uZpcX6VXaXg1fxXoH7hpfDp+aqWdzJ7PZzOYvMRfs1nXyM28KbsGrKdIx4Y0p9uuAa3V0IwqZhIPnxbr
+FYzNr5goJQKvJueFMO+BFE6DrYK185UdsbueqecDefGQbNOpPUT6Ev7OZBD+HVJOZeErKHOyhx3Nc9gx
+lcGfL97/9B2wAt6d9/hbGTdUBbMwVmTzgWxprPhPfEfFGyJpEJqJXqulDV3vYTIwq+7cbXJpOm1kluZE
+npACVhTw3E1TuGVEkwnrCTzwicb2Q7ObFUwBk3hCI1WuYJfRAgoOBgshG3ZLiz4rIhoWWu+EF4reKeBr
+ICBZsckpoPC0UPZ80O5d1mewginH5KEbCfY48Dea65wqBb/bB3Yx0rOZ4+AaNQjdGWEsTRzVA1anmMjY
+brMj1cvuSCWpelcoKm5JHrT0I+jvc7qrE036qsONJrR9o95Yz30hnqtI4Fu3+BF8cnKkiVa9Uzu7o2xM
+yjLfB44d7FlMn7rWxdAZ2Hg0Al7i32bk1b4pYFl7/FXrqUuzdA2tKjJGry3sGTp4tC4avVQm+E7n/7kQ
+XATeL8VNwXdFsxf0pY3vndSsTjwf1lzU9zhueOtwoyTJgN9SAXz1G02UhIwKc5eCsQcqo4C3PFQqmjZs
+RkYYJmPcHxzG17qIcbu2XmsOy9v+tVmLKIvGso0i1guBa0N0JyxxFC+JoD+icx33YNA9YvID+RAgYnOo
+DkPoxwXmUVAfw3vIroH6InYkdMWweVhzso+YHA3IEdIumfD0Gte0cg4jHMRv/dCyv++kJ6rZ9suaqFY+
+DDu52qZw7Jz33XStd7sNIOoK2iRa2Eimiy/S+6jHZ4ete+tC71Sc02KjMiwSOA09CrrdSSv3+PGgFGH4
+ZTmRRb+QuB4+4pFDpztB05inbX8N1J5QDNoAmS5Cl057n6RbmbZBCBPt+nahJYpNg93pLH93/gJkldyQ
+DR0BpDyptFaJoETRc6Nj4JHVShjdjmHUnfAe2WBnrrYlXjaSfDR2sh0+jZrcJHm+aB7w8nWV03YBq9Et
+hSVMTtvFnEj1luWKCliC77eA8Yqne508/jd3E2Q+wRU/dFByJlUXBVc6KOuatoNk1jpoWq8Oll8P0Qg3
+ncat8oaGG3QdTQx40bldsPq/XPavFaC21aVBuY5pbEbVNzmR0owSt9QPF51NB/ZsI9qhCcv2rqbu+bDU
+/eNnujm/K60q7mwaPzl59evy0Xz86T4IP19dXl1fTa+ursPpJgLv6mp86oUReBvmORKRPI/XXJy7vQAC
+lvZVZWlM45QqxOsp5JQrluoS1YWjEQ/tXv/X3dmoI2jUplyA7bjOMf9s9dKHE5BwAv7ZdPXSXzi1s8vW
+EG+oD/Fvs/cAdD86/qTtkaltbnicgA++Fohp4Vga37AiNSKyl/0YsE6Oy0pmaGwnEJzfOpdimQie5xe8
+DGZhNzJrKm397SvYxNps8YXAJWl6NGpbzXXq4nRlrq23pKyFiJzDX0oLxdZM+7u5OG8WY4r+anp1k6ys
+SMTrWt6GWEpzRfoXfj3FlwOp2XPp/ei46l/IWbMCJ0vQsgwWh7OvN/1DTnUaa1t1usoeZdNFgwmcPsxX
+P1/Oru3Sn6l+jzaBZj3JGS2UWT+U8dNBCRg7UrTWHSgVWK9pXHLJTCuKFS8P0VawBMyrMY15pagwggT6
+LUZ4WGAUnA1EwVHFnecQTkD1KDqOWKEPzJ5DOf4ovxVMjtNcHCk796Pfn742v0yjjHkR+DLju3glY73i
+O2nbT7JHJM8Poi3Pu42p9hYWO3109RN/Dn7C9StD5Ufg3+LCLRGMrHKKC2tcqNnigsIFfH+HD6mGMpqn
++LTFpy1VGU/9bgEeB/6TS5Ze+2H/IDPgExSR6jmhczbuIrAUlkDN9Omz1D/SU6a/Xv76t+vLXyfXT8bT
+WFGpsIIfay3YX3WVHwbXHXAOLI2OYuQ1SvdC5PgGdMdc/ysvaZzkXKKY/iXOyBNcRrMZRZslP7w+ThAP
+LYF/RqYv8SieCbqeg/+N6XQR+AnGHroK+8NkI3hVTpiiWz8CRVasSOndHPzJqX8fDrIY6t/3o4cw0K6S
+C+UMLSSC1ZAbdDmNtQ3hJazML6c1nS7g/sFNZwObJoO7LHB2VHR7fgmbVCy+mItmiA18Nx7tfBzjBYzv
+h/GaJ5UMwoVLPWMp/SLxmtAqr0Rvf5Kz5AZzMD506jAtU2T0v4a971wbjFx2mn5Gig2FG7qvymMk9e1L
+cxRwlO5NQhai+C9lWWcHPFo6w30X1g8Ta2ND5pi7bug+5bvClbVzzJY7ppIsoPEuY0mnUSdEUnj2/RzP
+e1XZ7V3N1BNMTnsxTvFt5S0t1I/m9jEIF9BBWAlKbhZdNs9nmg1KeozRv4TP6TPNhxaKioNu/MCZabB/
+2XkjuQmOdr9GjgPnLEYj/c/Q8RaF8XiREZmZePOAFbBjRcp3/UDL2CbLsRHT9KO+WG3fsIDdEruk3DH1
+4Li0hqBLb3BU6ON0ZlHPAXoP2uVAcist3geheDEK3RytYBrMP1/Fn68uP19df46c4yEMDFY92o8f95di
+Xd7/ug48+wXXxMOrepichl+nczO7fJ3C9w86JWgHHx0U0ylWmBUnIgWZcaGSSsl+qED7zcLtmwyW5hsX
+/Yg3NjjER1DfNegpra5ngW/rqy4RTi3I8JsXl0mzX4kKT0DO5ycsTWlxBNtwc2Pd8K2vf5rSVAoq5dHa
+hNolfLviesTBO6KP+iOpSavk2bLzmQJ01F+MmmUMCi3d8JHL6Ock6ag//NNYEbGhqn7LM+rO8fhx3bsP
+//HLhe++QcX/DOzj+U/nb44BL87/++L1z+evffi90iHzRjL9vqZQ5ylTOLXq68tjMGSLVP0/xnFLFfkL
+3eMbXRonSuR/ofs/YtkE49Zc1cb4qdWbjIg3PKVNP+q5UEdDn5FpYRDYRDiB5ODAqYu/t9l484HU9vFG
+JDIXfjEp2Ba76qfm7DOH2X3kr0l72Tegpc2vAZ6rP8bTTZbMnLTC/4sYbEAMfRMf+N+UN5uJrod++MC1
+zO8S3muJemHM12tJlTk0P6DEw4ocdhCrGv2CavU3uv9q7Wq6/38K3g/lTR3uA9OaN+2ZwlwxS0pEkk3M
+Z7q9ufthsQzVV8NU68bkh3Z8/nqS4K17NGuH1RfiX3BVB3WQ+8O2HrRs00mTbKifOC1odLTI3YeLpodv
+mUyGuvY48GVJmhe6fvsi33lpNzgN6U9y9IutWQQ/6InF/6aeYPx+79R3Awif4Jn3CwR/cE9qeDngXM+w
+wu9OeX7C85yUkvpNdfLx/w7o3+B0O76np2UvAo8VZaXM8DyRNKeJ8o4NAeOmwYWxQQ0ODmh1PO4mVUHv
+8P9VoKkfOgc2/zh132a64ptNXquH50CXVgSP2laa0eSGpgNCWEqmVMhy74xWZu8c/HiTpnwiWUpXRPjt
+PGUqyBxOZ50w+t8BAGY6JQKzMQAA
 `,
 	},
 
 	"/_layouts/default.html": {
 		name:    "default.html",
 		local:   "assets/_layouts/default.html",
-		size:    4261,
+		size:    4912,
 		modtime: 1500000000,
 		compressed: `
-H4sIAAAAAAAC/8xXS4/bthO/+1PMn9nDv0AltUEPxUIy0DZFWqBB9xAU6Ck7lsYSY5rUkiPvuoK/e0G9
-7PVKlhPsob5Y5Dw485sHh0EQLFZSZ1Ln7nYRAIDGLd3CB5NVihYAAJacqWxKtxBtm93wszN64SXrGm5a
-TrhN4CbsNYXd5uHQsFh6KJELz2Ms3ISWHipyHFZWhQ3hProfeI3hgbHEnEK/c8oV/+/dn798/PvuVyh4
-q5aL2P+BQp0ngrRYNkbHBWHWfjbLLTFCWqB1xImoeB38KCA6Z/C+J2In6bE0lgWkRjNpTsSjzLhIMtrJ
-lIJm8S1ILVmiClyKipLvw++eK1RSb8CSSoTjvSJXELGAwtI6EYOjh0MQrYxhxxbLcCt1mDonvkaLk0yd
-8FGaJSta1rVH8/85cQ9ps+/GItGFM/xAjBkyhh896zdw30X0nUmrLWlGlkb7cEAArf5T1XD/3viAwB2m
-G8xHxOKota0NVnSMVrwy2f4EAI07SBU6lwiNuxVaaP+CjNZYKRZgjaKGKPNG/Ql8jYZMDhp8PFFqsmc8
-53zdEd6qUd7W0IrZaOB9SYloF+JMAZs8VyTAI9ktvBFKYemGbbS5z8mwkxnI48c2R7sSdX+Us4HRai+W
-Hxv9cAQijjzflWpkanSwQiuW/x2xOGphnaDiGdorizobKQ6pM3oKfZe4iClbo/NlbgLzqDOTxlG3M2Ea
-jqRQlMnd5czqowvXRTuu1ImTvZDG3SVPlOxl6hrkGlDvjz3YN9H76AiJL0ZMWe6oroF0BofDBd0d7hch
-/s1saRSeI0xKnnSpC/Zn1pSZedStH131ulNnyk3edKEvdeEi2f9aF9+IWcYzU/uan5V71hN66Xmxttt1
-7WaWG63EoEBXmrIqE8G2oiuF6KlEnVGWiDUqNyO1nNXZXQRulvFZm0jREs+3lsmCnCilIVZb0pWYN76u
-waLOCW76+2xs2gk74h/S+ZKY99RXAVzxGyu4cpNHftmdGf6+9RPLnS+Kw6ErxEn6LFZDlQ4FNYN9pV6j
-3NsyL9DdWVrLp9M6/9TNnq/TroJ+km2B+oBarsnxK3Wti27gylT8Wl40yjonfvLfV3gwHcDFRO7L9cux
-8C+yTho9lRmT91b/aWVe8HWXWD9L0RPPgLQ7vkeutvQaYCZwmaiMkSngbCuONO5O5/QrRtS6BqZtqZAJ
-RPcuERCeGnB+itcqs0Q8BWtjmOwwnaaK0K7l01dOyl1KtPN+q3oMh7qGLdqNb7RXMZNyNEZ6T5osMmWw
-2kMslzFCPzN/WinUm37kK5hLdxtFueSiWoWp2UZ5QfwPqWiY6sTpgIfLOJLL8bTpTjX2Qv4cc2Bx8Ror
-K6UuZvxQ3W/E8mdMN8AG2JQTQ+bLy/BFfjXL49qlVpYMzqZnDeTzQ0V2H7wN34Y/NM/Pz665bhv+5RUK
-nj9ev1S6ebS+FIqj9hEYR+0L/98AAAD//xl4LHulEAAA
+H4sIAAAAAAAC/8xYUY/jNBB+768YfPsAEkngxANaJZWAQ8dJnNiHExJPt9Nkmvjq2jnb6W6J+t+REyfN
+dtsktxSJfdnYnvk883lm6nEQBIsVlxmXubldBAAgcUu38F5llaAFAIAmoyqd0i1E22Y2/GSUXDjNuoab
+VhJuE7gJO6TQTx4OjYimzyXawskoDTehps8VGRtWWoTNwn1038sqZXvBEnMK3cxQKv7qzR+/fPjr7lco
+7FYsF7H7BwJlnjCSbLkAAIgLwqz9bIZbsghpgdqQTVhl18GPDKJTAed7wnacHkqlLYNUSUvSJuyBZ7ZI
+MtrxlIJm8C1wyS1HEZgUBSXfh989BRRcbkCTSJixe0GmILIMCk3rhPWOHg5BtFLKGquxDLdchqkx7CUo
+hlvyykdty62gZV2D0vB1TrajtJk3507CH2f4nixmaDH84ES/gft2Ht6otNqStGi5ku44IIAWfwgN92+V
+OxC4w3SD+Rm1OGptaw8rOp5WvFLZfkCAxB2kAo1JmMTdCjW0/4KM1lgJy0ArQc0izxv4AX0AAHHGewR3
+nsgl6ROZUzm/hbPqrGxraGWtkmD3JSWsHbATAKvyXBADx6QfOCOEwNL006hzF5Oh1+mXz28LABCbEmW3
+ldGBkmLPlh8afDgSEUdObiYMT5UMVqjZ8v+jFkctrRdW8YTtlUaZnUkOLjN6DF2VGOXUaiXzZa4C9SAz
+lcaRn7lgGj5fiKOM78YjqztdmHfacSUGTnZKEndjngje6dQ18DWg3B9rsCui99GREpeMmFq+o7oGkhkc
+DiPYnvdRin9TWzpLz5EmwQdVasT+TKsyUw+y9cNnrxk6U25y5wF8qQujywDgXXzFJgVPTO1yflLvSU3o
+tKfV2mrny82kNGqOQYGmVGVVJszqimYq0WOJMqMsYWsUZkJrOYnpfwjMpOCTMpGiJjtdWi4m5IVU6s9q
+S7Ji08bXNWiUOcGNd+PsbSf0i79z41Ji2lOXBTDj71zClZs8ckO/Z/hu624sdy4pDgefiBfXJ7nqs7RP
+qAnuK3GNdG/TvEBzp2nNH4d5/tHfPa9TroLuJtsS9R4lX5Ox/6ZqtbZ316d30pJeY0pmjLu5rvMe7Vru
+HxE9BUeDZ5AwwoGPlpk0veGYS2UsT6/CU3aEuxZRA0jP1NDmJ8VqhVlOTdYJkhdc9JXsv+V4Ll24UpW9
+FlENmKfoJ/f9Qh/bWrKYjp2+Q/mTtOFKXgqgi1eo7lPzvLDz7lNew9KjnSBpV9dfbOkcYsZDYsaF9GQq
+jiTuhi3jjG6prsHSthRoCZhvkRmEQwNOd3GoPEvYY7BWypLuG6VUEOo1f3xh0+ZDom09W+hzPNQ1bFFv
+3G/+LGEShs4tvSVJGi1lsNpDzJcxQte+fVwJlJuu+yisLc1tFOXcFtUqTNU2yguyf5OI+gaDDXsNXMYR
+X54PG7+r0iPxM1IWnhSpshJiNOL77H7Flj9jugGrwKryQr/z/F72LL6a4XFsUs1LC0anJwXk0+eK9D54
+Hb4Of2heQj6Z5ubXyC9nADx9R/lS7eb95LlSHLXvEXHUPjb9MwByq0pbMBMAAA==
 `,
 	},
 
 	"/index.html": {
 		name:    "index.html",
 		local:   "assets/index.html",
-		size:    1023,
+		size:    2251,
 		modtime: 1500000000,
 		compressed: `
-H4sIAAAAAAAC/7xSTYvbMBA9S79i0IbeYrHdLZR2okugUMiyCzn0GtlSLBFHMvKk7WL834sdOx80uW4O
-wSO99+Zp5qF7UvtoDpWFtoVZlvtgfCib7GU4zN50sdOlzZY6xOALXf3c1zHRmyYHXYfSPSnO2xb+eHIw
-G9ENfFvc11r5hqDrepbfXnC6jqN7Bm8Wot6V83o8F4qzCcMZaigq3TQLUdu015UPOwEu2e1CPFyzPj08
-fv38HaVWHKV7Vpwj6byyE/9YDP/zIu5rXdBYufjbJqE4krPaKM6QkuKMIbkT2f6leWW3JNToDSU5xWH8
-3Yau30OsG98csf8LJl86Egp1nicgT5VdiHU8pMLCygfbwOsWltFYodar1yXKHqdGMZS9yb4YPCPl0bwr
-ztoWkg6lPQ162M3l0M/PMzc8c8bY9aayF+3Dj0MoyMcwCDCGuUI97qFtISaYZXWPTTFS3YdlIzfQdfWu
-lH3OJqmrNGWO9pVQd+/7XaLMJ0u2auzU/kN6T32DObZFSeb+4AaH3thfMZnmrDplAB6/DLK3JcYkjM85
-cYcs9FFYxkMg2GzOEsf9X/hDOUYA5RBrxc93p69/AQAA///4mZGR/wMAAA==
+H4sIAAAAAAAC/9xVT2sbPxA9S59iUMzvFov8kkJpZV0MgYBDAjn0aq0lW8JaadHKbcOi715W1vpPbDeX
+UGh9WLTSzHujefPWTN/y2suNVdB1MBpXxknjVu34MW+On8ViLVZqPBXOO7MQ9qFufIjPImpIiVF9yzHu
+OvhhooZRiW7hy+Qy1sy0EVLqs0ZatFNf18LJnLMUtlUHZzNTBRGMOjkMwq3Uji/T7bhT6jowy93OuDBs
+D44oJxDDRm0P1Bb9hPkoxsnDBcaF6RAzJcz0HRg5Ic16db0o+4RjNMRgxAQsrGjbCWlUqIU1bk1AB7Wc
+kKvjrP+ubj7//5VRwTGj+o5jzKKorBryty/52Wc1YhHLm/bfVSAcs6iVkBwjFgPHCLGod8nqZ7y2ahkJ
+L7UxGjXHUH7nQ19enW9a025jTwGDWelIOBNVFSCaaNWEvPhNWCiYGadaeFrC1EtF+MvsacpoH8cLGKN9
+kYyWmlmsvHzlGL2rOUZFi1PVD24uz1wHI9Trse1+14EPMBo3PUTwPjb9pM/pHFJq1ivadXuGIyuMdawt
+4V0HlWiVE7WC0XvmEbkoGuXl4vJgGqm++SDbPeIgAdx8ykjnIYoQ5U673CxFr8TUb1yE+XwPsW0/2k/4
+wZLRIgajecA4PmuEvXPeOKEpYvUdH4R73wn7rD/lhFLbX+8E5+NFN7zxyoOLKjhhIaWj+utNVJLsZP69
+h95gPgrj7jduEY13mRYhVvGPNNr5835AGK2Gkspn/aNNfpl74C3NRuhyr1nbCDd004pKWcjPa6mWYmMj
+GWbmydlXMJkpD/ky+Dr/5RoHp8X0Ifd9REqEm0LHaE/GDyv7J748+9WvAQDfgXAHywgAAA==
 `,
 	},
 
 	"/pkg.html": {
 		name:    "pkg.html",
 		local:   "assets/pkg.html",
-		size:    8743,
+		size:    26170,
 		modtime: 1500000000,
 		compressed: `
-H4sIAAAAAAAC/9xaS3PbOBI+i7+iF7HX8lRRTDLZR3lpXjyTmlTZO65xZvaSgyESkjCmQA4AKXK0/O9b
-jQdFUtTLW9nHXCIT6Bf6azS7mwnDMBhzkXExVVdBCACCztkV3NP0iU5ZAAAgmSoWMmVXEJV2efSrKoTZ
-K6mkc3Vl/rbPhuAKLtZr+E3Bo1t4hLNRiZzuGarqIkDl6zWcOWVwdQ1nI2/N6L6mDOKxTII440tIc6rU
-NUlzRuWErwjw7JqswlIWvwq6JEkwiCnMJJtcE5S8kXZXZIucje6YphnVdPTzT7dQVSSJlZaFmCb7qT9y
-naMlV3Hk6OOIJsFgvQY+qQ8wuqeSCd0wvGVNIb0TZFHokuoZPEaPUFXl0zRq+GFLzGim5zlBExcyf0hn
-bM42xPYgV1Fkt38olO5urteQcYnAwnAh83vU3CS5hKraawAeNlYlFd7/mq10OF9olpEkiiPc2k3QlPx3
-NAIFGhbjQJYr56tjBNgTtfhFhuwd/nKR56Hk05nGoNjg8Kp8moZcZGxFkg/4Y4Hcrfyfta6OkAnPmSLJ
-e/yxQjxlHGV8mQTBToO+K1K4KZZMmquyXkPJZMpEA7gHTTVXmqdqdMeogDdQVee1/NlbE/doBUpZcvaZ
-JP5m9bp79hbtKdHItMhYwudlITWQJvENFYXgKc0/mE0TJlVF4shwBHFUJkHQifiHZ1GUiitEYL2GOZVP
-WfFZ7CTwYMWzb+sjWDS8nxRLNS9EOGM0YxLBMzBBTGtPMjmnORdPpAfTP75689e3fzN4xNHsWzz1Ivec
-OVc6XAiln3OENhjEfwhDuCmE0lRo+I6lOZUU1SsIw+377SnNaTDdxTlP2lGRehKS1NTm+kQ578YrKveX
-7BcqOR3nrF/xZnen4qUnIUlNvU/xw2LsIqZf5b3f3KnRs5PE0x5x0DBnS5bD+4UwMPf7XFIxZXBWE+GL
-wdvlF1uGBQNvvt8e3RTzOV6pqmqkeGdS8yrjDaiZ3H0hyWQhUmhtPfCpoHohfT7crzLa1rnLMR+fS7bX
-DYag6QJc6B6/fSCk2BxGo4DusjVesJVbxned0USUlotUE6iqDVO9axjtAYwXULUJJ8MEd2w+ZtIdwTmI
-/bZPBwpY5EjdOPId07MiM4f2jLMiU5Yc6cB43i6PPijnmVsTW5Zo41tzD1FdIcEJtuYNnO9qJJ28/aGz
-z9cjXHBSavcbnkEdUW67GU9Wqg2qPcZEfda4sHJe2aw348x5w4ID3pnOCad6XRT6gOf/w161fw0bRD+x
-lPElk44Y2obcF1xoJj0RVNU3tSVd1ZdW+H8FN6SxN2NfFrE03ffy9ys6L937Ip69q9+1zK2j/zyNqVL3
-vls3XO3X67sDr9dNaDldrTTWtHFQpzH0gVPt1IbofUc8el8gYGFr7ZaOTfAR5C1EmvP06do8DM6GF6/Y
-6mgJFw7vEc2yGzzU8IKLelGyebFkbj0t8pyWitW7M4bF3fCCLnRhF9EED3FHPWC4llSlNOdf2LYhl4EP
-rB2AN653B3pTkHZxd8Xqye2RL0+aVe6BYHGqeiJFY1FS19jmwfwbpsW8pKl2TzOsaUkSxBprQNSopYkN
-PWsV6DmbaFt/Y2sTR3rWR+VK7gf+5RBJTMdjCRpbvWvyYFpeuOWCKfhxAjdFxkjycPvjTRwhXXJImCma
-MT25+vkoenxTn0Jvqs1TGJZUtsnjCJ2LD8bXsR4X2XO7CuOde1sH2AaYrAeYYJMSkWN0R7moKzqXA8dJ
-Kx4NXZ3huyu2uhx7wXXfOBicKGQ7zeqs7xzOc+s14J0eP2vnjREGE5Dz0esJMSIPsWPnbxlNVGFQ3RQL
-oeHx8TR+77+XceOL7WWcvpV5GbfvR7a5bfS1kpsLwDgyyWBfomt1Y61OztY2zR6z0ZYFg5rscDJr8G11
-ls2BVMbSnACmzvCJi+ya+FmUSye/cPYZLPpevpH4+vVfXluZGKglzT4WeNdyJmCYF2LKlH7QkospDMt8
-kT71nZ5gaJPLS3slS2md5m5v3dw2b3DDT1UVmDOCHVagv9AST9G6SKXkQk9g6H7J+XkI55kizvDLbTY3
-sYBraAn9flVKppRLBA7h0JY3xn43QdmNfashNti3G+gm9o3OOBjUZIexb/D9j2G/Oete7D1ZC/uGn6oq
-WFLZQd7vn4h8l62NfL377yPf6v9PmiycMljw8dM7HWhiPUGsTW93APA+SYcLqv7pxN5wRGMwJMHE5YmR
-aGPo0PSjiVRQd+M9c5AgLpPmWHAoWZnTlPUQk0+CAInHMiEQvrm0epKg2R0154e7JyfHDE6a8LZmJU1o
-TQ2DFdlBF3bFHIdre0jTM7jcl2Oy45D1Ik0LomSK7khn8q3SEoYLMaaK/fmdM8WVvKSkWcYyYnOKu32G
-4AeqfhZsVRZSs+w9Z7mbDRiMjPRrkKsaYlx4/CSqT+qbs0cgAFEEaSE05ULBhOeaSZZhlbCohcLESP0k
-KuIl140vBp0/xVYQHpz2rNcwOGLOYY5yYLjk+6qeqcSLM0NbznHx052I9HRcXykt9IxCttI3dB15TFbo
-kO7LCdDKC92nVp7omXudHA77p17NkDg0ueqNkeDrza9+V9H3lbz0/xXV7eJoR53U/H7T+8GnWSJvPuUE
-A894uEDecPW8vL7KsMfZtmvUYYn8J8ejJxzN/3/Q577Dc44XfeZvfWbdfOPf/nhrP1Dv6LdbwxaW84z9
-o5CZ6vkC++ZPrXHHKb33vwIAAP//YkFp7CciAAA=
+H4sIAAAAAAAC/+w9a5PbNpKfpV/RR9tr6cqS7GzuUWON6rKOfXGtvZ7yONkPt1dliIQk7FAAA0DzWB7/
++xWeBEjqNRPHk83kgzwkGo1Gd6PR6G4io9GoPyc0I3QpTvojAKBojU/gDKUXaIn7AAAcC7bhKT6BSWFe
+j/8uGNVtBeJoLU703+ZZA5zA07KEnwV8ti8+w+NxoXraZ6iqp301eFnCYzsYnJzC47GjZnzmIfvTOZ/1
+pxm5hDRHQpwmaY4RX5DrBEh2mlyPCs7+TtFlMuv3pghWHC9Ok7IMsb1n2SbH4/dYogxJNP7x4zuoqmQ2
+FZIzupzthv5EZK4oOZlOLPx0gmb9XlkCWfgJjM8Qx1QGhEfUMO6YwBmTBZIr+Dz5DFVVXCwnZbkdzXgl
+13miSNzw/Dxd4TWugc1ETiYT0/wDE7LZWJaQEa4EC4MNz8/UyCHIEKpqJwFqslNRIOr4L/G1HK03EmfJ
+bDKdqKbtAA0mvWLrNaKZoWuOBNaE+ea364JxqWnUEDgX2PzlQf6C1vYV1ngsAf1eDd7v7SKnwZ+ov8bY
+b/QvNnk+4mS5kkrFmmJ/S9N8k2HxI8XXinisUfR60xzNce51doXTizm7HhGaE4qT2ZTQYiNB3hS4bnUa
+LVbsarTxCBPQADibQT3KdKIHUBRtne3/+ckFs3MzoEx2yaXfq/X2UXGxHBGa4etk9lb9YxT/6AFjhAuS
+Y5HM3qh/DELXazrJyOWs39+qNP3p6hvNI4WHXWJ+SfBVMkstQKdOvUKUUZKiPFKu6WT1zazfnxZq+JRl
+eLZkQKiQKM+hLPd1/68cSSzkdKK79qeTYtYPFHCr/nzPUnilCNd2siyhwDzFNJDEuUSSCElSMX6PEYUX
+UFVPPIM6p+/MascyaU+T6GlAsn+OSWt2dnm0VoDEnKJcT7zoUot+79OKCCACEAXiwC3ZTWyaBDTP8RvO
+1lBVL4FISBEFRvMbmGMwM8AZzG8cDgFXRK4IBTPHstyBz87Kz2fcmt/0X0YjyFgKSq8wlQIQx8AxzTDH
+GUgGP3x6/w6QBKHokmSNX8Ia8YuMXVEokBBYgFxhwmGes/RCPXC2Wa4AiRERMBrNmjw8v6GsEESo8cuy
+RtYC0CNXVUhuWQJHdInh8etrtC5ys5e6jvadMDbTrXv7dvyGcdMg8bpQOg0JNk1JjS6wttu14HuClpRp
+xdWKEGzYKMdcgv4dXSFOCV0mxuDmmG7DAAVn8xyvB2IIV5hjwDRlG6U7OIOrFcmx5r5CBlJpl1WFZyCY
+0pg1MspCU6YmIXF+o2S6UQLF2bjfO8cYDtqhR5OspsxuxwGtyoSNnelq8WebldU6Zp+NftXEKcU26uNW
+tlVEQDmj+BlwJFeYg1whCojCd2dvQVtprVnT1R+9jdBvEycHgVNJGB2tMMowVyLQRl1xwUIUmK9RTuhF
+0rED/OHRi//85qW22NPJ6o/KrGz8BpcTIUcbKuRNbla8naCyqFTC9zjNEUdqeKP/vZaNN5D/zdmm0BoE
+ADDNySzePFILJ5KZ66IlMJ3kpLmJKwosfvgJcaKMQPfornXf6JcOSzLzCHeNfr6ZewvVNe6Za9w6ouue
+zBzsAbMd5fgS5/BmQ7XAu7lvbYYHCo2GexkSFuizax6/rl0epwiB2+LJC9wm3/WVVemqCrzwDo+hLINO
+dldLZosNTSFqOidLiuSGO5d195CT9pjbePrppsA7OagBQu6pF5pzztPC1wZKnyk0eKJ3wQVKcdLNYg1+
+BHtjhunenlnKyYTma8sc/Ur9nOnDHFTV/9QzI8/gsW/TU2yDWzwEquoZhPtE3dMNCfFbvX45Iloivuf/
+hji2ME9IvkllEqKsW6OzAZr1+z0jx3PdCd7j9RxzK0QrIPzzrjEUgo12tAOhv8dyxbKaJ+bZSF3DgWaL
+eT1+K6xuvNML0wDV2mU4sUkl42ARG/J6sVJYbEeohV8EtuvuVddUI9vJK5IG6fm1Z5vDlWeQTNCesSdd
+g9sFaLlXvw9XpOWaESI4po9Gt5JOyNNuCX1V7oerdbxbHOavQQD0EaeYXGIeLL2AkDOm7Y8Dgqr619bS
+bRiKBt7DTcaujgcYj27bMDQz/rpaeMbZmilNOFgPXY9YH6c5aZ+aditerXGeLqsPenUObi/u4e6lLdYo
+z2eDhTpLBYBuYvaMNZxODGCTo/UGPzEmddcG3N6L37pd824bcpe9f9iQ927I4HnkNlYzFuMGizez5skL
+K9J2t5Uesvv9stvtw1baZcTqJXWwFesW7H3fLbew+Rfg8G2NmgFvBlKCgE1/uvrWn+VtXEYo3XEwOsew
+8+xe94qP79/uOb4fHFTqK7kbg6fYYYe2w44U0y2wl4QCYzTNSXpxqh96jwdPH+HrLuCndqMfoyx7pUgd
+PCXUv+R4zS6xfZ+yPEeFwL51hVXMdfAUbSQzL9VoTmiM14OpKNjnM5es8ibUNb/Tkfyq0ttq6+0w1gDU
+OFY48I9YbHIJyQKRPNE6HYSITa5A/44yxXaegFRpp9Pk0wqD5SZkJKNPJRScZZsUA5EC8HWBU7W+2EYW
+G5nMFH6VHVDodx5vjfbVz9tDezpS31RHG8U/OudmkUIY/t+jwwuyTYF1XNd1Ng/6d6SCfiiV9mmlYuXJ
+rD+VKvSlRpRcq6xcRY5XjhfSJCZUCmE6kasuKBvKPyf/2AcyRfM5d4I813lUeEcoFvBhAa9YhpPZ+bsP
+r6YTBTfbh0yHrdWOYSPYB8ErV+MYeB1fO6bDJeIx+HSimDudWF5P5ZxlN3HIiTTMiVewWjBZh2D6tZVW
+PcbvEaE+fGXN8nwW6eMbUtuSZNZ8Y0Jpc4fYpw97vSORtDcCmXXNw3KuLEEZpfmNtNwYK2WC5Mn4+ULb
+hr3dGXcdtVYppXqlouPw+fNx/R3/btdbbbq36+mCt7fr7YKv7d5G+yIzZxVwOtHGoL3hvl1SxnFWW7kt
+OazX1zrTq8Pz8w3JM0i9wyxO+r3YTXdWJFL0xlDdrnpL92hT9WiteeFEi53mfFsmYm8gPorki1aOIYjI
+93sebL9VD/q1Mgs1KzUZEQ/bBJYlQJDAukT5BovE9a2qXVzZkQLQ847zBuG8g1xAv+fB9s876HfUvNsE
+/iLzjkL9RyURjskhqHTgnRIIjvOd6QBQHsbogtDsNFkocehz1RS5rfcngq/AWMqkXlsqZRwgO2OC2J2k
+LEHvSVBVj96pB2VfwzOxXYoNOux6tEw5y5FcMG6O6fUo9q3iCmz1AQldMO8BflB570xzH2ewYBwEW2Mo
+HCZb1WIf65KW0PnboZPdCZaWaoYJXcVeRZCmyvqAX47Vf3j04vnz/3j+0jqLBcc+/eMCPuwC05jP/oin
+2wJjq59d7U9oacOGZFa/+YSvZWBs64qkqLmdIZ9OFKVhTQtsS0fFKf8mQJDyh2A9dxzPgmUVJv0PzO23
+c9jNmojuVNghgbdDE2ENO3Fc0C20EVHALbQP2o9UXvGhWmsCt0cbh5AAqztfI7zXWiFd4b6vtka6s35d
+BtRI4V4azzi221ElEVhObTBDdcwON5+3UMSAmANTnXqdmxemLCVHRAcXYEFwrn1f88fgAuNCFd/oR1Hz
+WLIl1uUpjAKjGHJC8VBX5cgVkv0eqP/kCls8UpuIFFGYa9gLnPl4PsDjAmWfmNKAHFMY5IwusZDnkquR
+B0W+SS/sdN4YMhLt/ChpiGQ4NFPSdvg+xdx/c4vSakQj3G9Zbh5+QEEJrG1Rsw3dRPW25qAFGvntwRzv
+cJ6NX6/nOMtw5p5roQbgtq3eRuO61JStk9lkovmNixyluNkj+RtN1M/f5GQCCYxe1Mu/3vp60WCesNBQ
+Be6HBlKz+6rSM8CaG6HTbWbQ3k2DY66zQ50bbFlCwQmVCxjYf5MnT0bwJBOJXajDDoF5nsI95lhQcOJI
+QkuoKvhclvGbz/t2KgP8VbYq+9eo9SdZ7Fyl3QsnZVQiQgUsSG7KLhkPEs/W8LcWTKCBZRUFoLUdjgJt
+xsYLnirOpSv+jZAcBhuqqrj//VtLsw2cJgVSKy8JjHpZms7e2e61nNna79a4tvncYePh/rZ1T2/jaxuH
++hxzgnLyD5zBGyVT604HBNcQCgDJKA7fynsJD63cigC70pjjPJkI168Xesd55sLOwcahp16zvJMp23DW
+JeEWjS8BDwaqlXR/8LprE6trC9pmdk9UO6BP9/RnBkdjditmmKWlbNbJKSyQD23X51Bn1IQzk2gJp7aQ
+OGyt+RaFuPeE6M35TZvMfm/7JyPO+P2FQSQikGj5UruJGV4glTlT9d8qtQSoKHKCxTjpYpr7/MSbGb2S
+0HJ8fkGKPbTMxAUpCp8868gKeFEpjH/GN7WcojIE1fphTeTrdSFvdub6zNySGVsTZTnkTfv40Z1ZaOns
+thRzM/odWrX6VJ8FAV5Es0bYsyssaS2m63R0OLIbYT3ucQj7e8tJyhJ6B1ST6DH3FJM0ghTHVjqE1rtR
+pHCXQKav9To2UhHTsCOI6Ua4l6fwZrlHx5Z19wjmrXh8TPyyWaByT6KXdlVAd4VM7ErFzZEzBfscKr+U
+7ha+jMdpmLuO2uGjbcPuyuFf0D7sq2rqNBj931kl8IN9PDJKOf5tGszfk1Y/7A5fZ3forOmPj+QdRfxb
+TXZhYdWKaaI+cs3WqG5xGk86z7KHfJ4wlfxOVb27Dr1f7FuFxuG5s6IIwpOf/p6hPt51f9UQYz2g0qit
+Xm+VXppvytuKFTTu0iniwfQnvP7pSH0K0XTWBkeq8hEvggLwiNC6APiI604+4kV824f+sPqRa/K7kpeJ
+Sx83Om69OkQDBteoREoUDOGFGn4yqvsaRWwe30OtGagbCAzY8JiKWzg4477jg5fD0+77P3f58ml38pB2
+v9cZvn7Pa8RDBv4LZeBdzuKIRMe2IqJ7lsyI6XJJUm+8bFmtqqFVTe1iWdcjIKQLx5Z6Wb9tB9nZ5i0v
+vjwWthCtfs6xrElVL0Bg2SLWQsaGwnYNKHzZRaHrexCBsceAM/jTzS6vAWd/ujnMcTC7SOQ9aOy39SAc
+vtu6EY7yB0/i1p7EQwD8IQD+EAB/CHEcEOLo/uJ3l3no+uD3KwW51/bj1LjTg4H4p48A//aMxV1Nxa9j
+KPZHIrZ/OxReX9Z531n4vVR9k1m/5zru/1qq7tVxrPsiNUiWtm0fvxogdx3jwd+8htccd7Fv/5evt7pN
+uO0Y/ypX8qLwW932QPvrYeyNrh3VMNtiulH9Ec5Jhv/KeCY6rth88W8RpsPiuNEO+h0sdQkKW4D/ilHJ
+xH/a96yjHN5ushleEBpUs7j1XlfCjP07srCvtxqQqLXrrL8z9HF5sBkf2LIwM95PmnZ4PryLWT+8vj8a
+NFHaFpf2Wz0zYG/X640xBVWlhVPr7CXijVrfpYSBGjgaYqjv3IVBo4bWLmQNo5dxRFZdaLsDZ1BOFgQH
+9GJwvpAG91ty4GCZhi9dvR0NX68+stBVYbZZnzIHjLvn19cFx0IoNRgEtHpJDId2wmH/dt11C1lVwWnQ
+LWppdA6oc0tlsE0Qw5ZX1vWpQIzMfCrQ+EggKDEMxte/mj2DWHCW+CEMFPRAxyBM458JzfRnOOpm3GHU
+pH87kAx3zsIF/TvQG5Z5Dfg5iUdq2vh2W3jr+r5aeNP7HtTC716Xw3aZevs+X2P5qbuM5Rm4C2cy2FBJ
+csDXBaIZzmAgMNalq8ZosoXrI4bNXcD7aQ1T7d+TzD+MlFENLpCaImPLJVsuFc8cPd5/wtdxF+sKGnbE
+t9p03GbT/GrM32BTICGS9jX7oSzFJk2xEF6c4SU2V0gA39Bnes3Yq2yy7rts1EhBOW6w3iKC3JU6/d5t
+LtWJ6Dn6hp2o7lariGpWu5eTOSCOQd3oT2sN0R+4aXkb+UZyqtezkWeHCNyMgdD4/j8r29pdCL2Fhp8Q
+eVUq4t3rqTtpLIq/rliO7X4O2+OWVwoMFiTHrbjlifVa93sXdzod9nTgvLcju7IjsRJcYTd+bQX9QcsZ
+xrp62z44Jlnm/EgZN5fBV5WFGBAKiN6Abhie1FbUtJ+EZ8WQ3OawMWmN/2fDFrXvNa4ssdo++y6VG5Rb
+xT1pDmxadw5rrWDLGP7/AMO15sU6ZgAA
 `,
 	},
 
@@ -1482,6 +1569,8 @@ var _escDirs = map[string][]os.FileInfo{
 		_escData["/-/about.html"],
 		_escData["/-/bootstrap.min.css"],
 		_escData["/-/bootstrap.min.js"],
+		_escData["/-/diagnostics.html"],
+		_escData["/-/interfaces.html"],
 		_escData["/-/jquery-2.2.4.min.js"],
 		_escData["/-/module.html"],
 		_escData["/-/site.css"],